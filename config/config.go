@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 // Config represents the complete logger configuration.
@@ -14,6 +15,9 @@ type Config struct {
 	// Level sets the minimum logging level
 	Level string `yaml:"level" json:"level" env:"LOG_LEVEL"`
 
+	// Levels sets per-component levels for named loggers ("*" is the default)
+	Levels map[string]string `yaml:"levels" json:"levels"`
+
 	// Format specifies output format ("json" or "console")
 	Format string `yaml:"format" json:"format" env:"LOG_FORMAT"`
 
@@ -32,6 +36,49 @@ type Config struct {
 
 	// DisableStacktrace disables automatic stacktrace capture
 	DisableStacktrace bool `yaml:"disable-stacktrace" json:"disable_stacktrace" env:"LOG_DISABLE_STACKTRACE"`
+
+	// FieldNaming selects the field naming scheme and per-field overrides
+	FieldNaming *FieldNamingConfig `yaml:"field-naming" json:"field_naming"`
+
+	// Encoder controls how times, levels, callers and durations are encoded
+	Encoder *EncoderConfig `yaml:"encoder" json:"encoder"`
+
+	// Limits bounds the size of each entry; zero values are unlimited
+	Limits *LimitsConfig `yaml:"limits" json:"limits"`
+
+	// DuplicateKeys decides which field is written when With and the call
+	// site use the same key ("last", "first" or "suffix")
+	DuplicateKeys string `yaml:"duplicate-keys" json:"duplicate_keys" env:"LOG_DUPLICATE_KEYS"`
+}
+
+// LimitsConfig contains entry size limits.
+type LimitsConfig struct {
+	MaxMessageLength int `yaml:"max-message-length" json:"max_message_length" env:"LOG_MAX_MESSAGE_LENGTH"`
+	MaxValueLength   int `yaml:"max-value-length" json:"max_value_length" env:"LOG_MAX_VALUE_LENGTH"`
+	MaxFields        int `yaml:"max-fields" json:"max_fields" env:"LOG_MAX_FIELDS"`
+	MaxDepth         int `yaml:"max-depth" json:"max_depth" env:"LOG_MAX_DEPTH"`
+	MaxRecordBytes   int `yaml:"max-record-bytes" json:"max_record_bytes" env:"LOG_MAX_RECORD_BYTES"`
+}
+
+// EncoderConfig contains entry encoding configuration.
+type EncoderConfig struct {
+	TimeLayout    string `yaml:"time-layout" json:"time_layout" env:"LOG_TIME_LAYOUT"`
+	TimeZone      string `yaml:"time-zone" json:"time_zone" env:"LOG_TIME_ZONE"`
+	LevelCase     string `yaml:"level-case" json:"level_case"`
+	Caller        string `yaml:"caller" json:"caller"`
+	Duration      string `yaml:"duration" json:"duration"`
+	TimeKey       string `yaml:"time-key" json:"time_key"`
+	LevelKey      string `yaml:"level-key" json:"level_key"`
+	MessageKey    string `yaml:"message-key" json:"message_key"`
+	CallerKey     string `yaml:"caller-key" json:"caller_key"`
+	NameKey       string `yaml:"name-key" json:"name_key"`
+	StacktraceKey string `yaml:"stacktrace-key" json:"stacktrace_key"`
+}
+
+// FieldNamingConfig contains field naming configuration.
+type FieldNamingConfig struct {
+	Scheme  string            `yaml:"scheme" json:"scheme" env:"LOG_FIELD_NAMING"`
+	Mapping map[string]string `yaml:"mapping" json:"mapping"`
 }
 
 // OTLPConfig contains OTLP-specific configuration.
@@ -41,6 +88,9 @@ type OTLPConfig struct {
 	Protocol string            `yaml:"protocol" json:"protocol" env:"LOG_OTLP_PROTOCOL"`
 	Timeout  time.Duration     `yaml:"timeout" json:"timeout" env:"LOG_OTLP_TIMEOUT"`
 	Headers  map[string]string `yaml:"headers" json:"headers"`
+
+	// FlattenGroups exports grouped fields as dotted keys instead of nested attributes
+	FlattenGroups bool `yaml:"flatten-groups" json:"flatten_groups" env:"LOG_OTLP_FLATTEN_GROUPS"`
 }
 
 // DefaultConfig returns a configuration with sensible defaults.
@@ -66,6 +116,31 @@ func (c *Config) Validate() error {
 	if _, err := core.ParseLevel(c.Level); err != nil {
 		return err
 	}
+	for _, level := range c.Levels {
+		if _, err := core.ParseLevel(level); err != nil {
+			return err
+		}
+	}
+	if c.FieldNaming != nil {
+		if _, err := fields.ParseNamingScheme(c.FieldNaming.Scheme); err != nil {
+			return err
+		}
+	}
+	if c.Limits != nil {
+		limits := fields.Limits{
+			MaxMessageLength: c.Limits.MaxMessageLength,
+			MaxValueLength:   c.Limits.MaxValueLength,
+			MaxFields:        c.Limits.MaxFields,
+			MaxDepth:         c.Limits.MaxDepth,
+			MaxRecordBytes:   c.Limits.MaxRecordBytes,
+		}
+		if err := limits.Validate(); err != nil {
+			return err
+		}
+	}
+	if _, err := fields.ParseDuplicateKeyPolicy(c.DuplicateKeys); err != nil {
+		return err
+	}
 
 	// Apply OTLP intelligent configuration resolution
 	c.resolveOTLPConfig()
@@ -129,4 +204,50 @@ func (c *Config) resolveOTLPConfig() {
 // IsOTLPEnabled returns true if OTLP is enabled after configuration resolution.
 func (c *Config) IsOTLPEnabled() bool {
 	return c.OTLP != nil && c.OTLP.Enabled != nil && *c.OTLP.Enabled && c.OTLP.Endpoint != ""
-}
\ No newline at end of file
+}
+
+// Clone returns a deep copy of the configuration, so the copy can be
+// modified or decoded into without affecting the original.
+func (c *Config) Clone() *Config {
+	if c == nil {
+		return nil
+	}
+
+	clone := *c
+	clone.Levels = cloneStringMap(c.Levels)
+	clone.OutputPaths = append([]string(nil), c.OutputPaths...)
+	if c.OTLP != nil {
+		otlp := *c.OTLP
+		if c.OTLP.Enabled != nil {
+			enabled := *c.OTLP.Enabled
+			otlp.Enabled = &enabled
+		}
+		otlp.Headers = cloneStringMap(c.OTLP.Headers)
+		clone.OTLP = &otlp
+	}
+	if c.FieldNaming != nil {
+		naming := *c.FieldNaming
+		naming.Mapping = cloneStringMap(c.FieldNaming.Mapping)
+		clone.FieldNaming = &naming
+	}
+	if c.Encoder != nil {
+		encoder := *c.Encoder
+		clone.Encoder = &encoder
+	}
+	if c.Limits != nil {
+		limits := *c.Limits
+		clone.Limits = &limits
+	}
+	return &clone
+}
+
+func cloneStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	clone := make(map[string]string, len(m))
+	for k, v := range m {
+		clone[k] = v
+	}
+	return clone
+}
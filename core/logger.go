@@ -1,6 +1,10 @@
 package core
 
-import "context"
+import (
+	"context"
+
+	"github.com/kart-io/logger/fields"
+)
 
 // Logger defines the standard logging interface used throughout the application.
 // It provides structured logging capabilities with context support and multiple output formats.
@@ -26,11 +30,29 @@ type Logger interface {
 	Errorw(msg string, keysAndValues ...interface{})
 	Fatalw(msg string, keysAndValues ...interface{})
 
+	// Typed structured logging methods; fields map directly to the engine's
+	// native field types without boxing or reflection
+	DebugF(msg string, fs ...fields.Field)
+	InfoF(msg string, fs ...fields.Field)
+	WarnF(msg string, fs ...fields.Field)
+	ErrorF(msg string, fs ...fields.Field)
+	FatalF(msg string, fs ...fields.Field)
+
 	// Logger enhancement methods
 	With(keyValues ...interface{}) Logger
 	WithCtx(ctx context.Context, keyValues ...interface{}) Logger
 	WithCallerSkip(skip int) Logger
 
+	// WithGroup creates a child logger that nests the fields of later With
+	// calls and log entries under name. Entry fields such as the message,
+	// caller and stacktrace stay at the top level, and a group without
+	// fields is omitted. An empty name returns the logger unchanged.
+	WithGroup(name string) Logger
+
+	// Named creates a child logger whose name is appended to the parent's
+	// with a dot. Named loggers resolve their level hierarchically.
+	Named(name string) Logger
+
 	// Configuration methods
 	SetLevel(level Level)
 }
\ No newline at end of file
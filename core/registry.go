@@ -0,0 +1,148 @@
+package core
+
+import (
+	"strings"
+	"sync"
+)
+
+// DefaultLevelKey is the level registry key that applies to every logger
+// without a more specific match.
+const DefaultLevelKey = "*"
+
+// LevelRegistry resolves the level of named loggers using dot-separated
+// hierarchical names. A logger named "payments.refunds" uses the level of
+// "payments.refunds" if configured, otherwise "payments", otherwise the
+// default ("*") level.
+type LevelRegistry struct {
+	mu           sync.RWMutex
+	defaultLevel Level
+	levels       map[string]Level
+
+	// configuredDefault and configured hold the levels passed to Replace,
+	// so levels set at runtime with SetLevel can be reset
+	configuredDefault Level
+	configured        map[string]Level
+}
+
+// NewLevelRegistry creates a registry with the given default and per-name levels.
+// A "*" entry in levels takes precedence over defaultLevel.
+func NewLevelRegistry(defaultLevel Level, levels map[string]Level) *LevelRegistry {
+	r := &LevelRegistry{}
+	r.Replace(defaultLevel, levels)
+	return r
+}
+
+// Replace atomically swaps the default and all per-name levels.
+func (r *LevelRegistry) Replace(defaultLevel Level, levels map[string]Level) {
+	copied := make(map[string]Level, len(levels))
+	for name, level := range levels {
+		if name == DefaultLevelKey || name == "" {
+			defaultLevel = level
+			continue
+		}
+		copied[name] = level
+	}
+
+	configured := make(map[string]Level, len(copied))
+	for name, level := range copied {
+		configured[name] = level
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaultLevel = defaultLevel
+	r.levels = copied
+	r.configuredDefault = defaultLevel
+	r.configured = configured
+}
+
+// SetLevel sets the level for a logger name. An empty name or "*" sets the default.
+func (r *LevelRegistry) SetLevel(name string, level Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if name == "" || name == DefaultLevelKey {
+		r.defaultLevel = level
+		return
+	}
+	r.levels[name] = level
+}
+
+// ResetLevel drops a level set with SetLevel for a logger name, restoring
+// the level passed to Replace. An empty name or "*" resets the default.
+func (r *LevelRegistry) ResetLevel(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if name == "" || name == DefaultLevelKey {
+		r.defaultLevel = r.configuredDefault
+		return
+	}
+	if level, ok := r.configured[name]; ok {
+		r.levels[name] = level
+		return
+	}
+	delete(r.levels, name)
+}
+
+// LevelFor returns the level of the longest configured prefix of name.
+func (r *LevelRegistry) LevelFor(name string) Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return lookupLevel(r.levels, r.defaultLevel, name)
+}
+
+// ConfiguredLevelFor is like LevelFor but ignores levels set with SetLevel.
+func (r *LevelRegistry) ConfiguredLevelFor(name string) Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return lookupLevel(r.configured, r.configuredDefault, name)
+}
+
+func lookupLevel(levels map[string]Level, defaultLevel Level, name string) Level {
+	for name != "" {
+		if level, ok := levels[name]; ok {
+			return level
+		}
+		idx := strings.LastIndex(name, ".")
+		if idx < 0 {
+			break
+		}
+		name = name[:idx]
+	}
+	return defaultLevel
+}
+
+// Levels returns a copy of the configured levels, including the default under "*".
+func (r *LevelRegistry) Levels() map[string]Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make(map[string]Level, len(r.levels)+1)
+	for name, level := range r.levels {
+		levels[name] = level
+	}
+	levels[DefaultLevelKey] = r.defaultLevel
+	return levels
+}
+
+// NamedLevelSetter is implemented by loggers that support hierarchical
+// per-name levels that can be replaced at runtime without rebuilding the logger.
+type NamedLevelSetter interface {
+	SetNamedLevels(defaultLevel Level, levels map[string]Level)
+}
+
+// LevelResetter is implemented by loggers whose level comes from a
+// LevelRegistry. ConfiguredLevel reports the level configured for the
+// logger's name, and ResetLevel drops a level set with SetLevel so the
+// logger follows its configured level again.
+type LevelResetter interface {
+	ConfiguredLevel() Level
+	ResetLevel()
+}
+
+// LevelEnabler is implemented by loggers that can report whether an entry at
+// level would be written, so adapters can skip building disabled entries.
+type LevelEnabler interface {
+	Enabled(level Level) bool
+}
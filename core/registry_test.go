@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestLevelRegistry_LevelFor(t *testing.T) {
+	registry := NewLevelRegistry(InfoLevel, map[string]Level{
+		"payments":     WarnLevel,
+		"payments.api": DebugLevel,
+		"db":           ErrorLevel,
+	})
+
+	tests := []struct {
+		name string
+		want Level
+	}{
+		{"", InfoLevel},
+		{"auth", InfoLevel},
+		{"payments", WarnLevel},
+		{"payments.refunds", WarnLevel},
+		{"payments.api", DebugLevel},
+		{"payments.api.v2", DebugLevel},
+		{"paymentsx", InfoLevel},
+		{"db.pool", ErrorLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registry.LevelFor(tt.name); got != tt.want {
+				t.Errorf("LevelFor(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevelRegistry_DefaultKey(t *testing.T) {
+	registry := NewLevelRegistry(InfoLevel, map[string]Level{DefaultLevelKey: ErrorLevel})
+	if got := registry.LevelFor("anything"); got != ErrorLevel {
+		t.Errorf("Expected \"*\" entry to override the default, got %v", got)
+	}
+
+	registry.SetLevel("", DebugLevel)
+	if got := registry.LevelFor("anything"); got != DebugLevel {
+		t.Errorf("Expected SetLevel(\"\") to change the default, got %v", got)
+	}
+	if got := registry.Levels()[DefaultLevelKey]; got != DebugLevel {
+		t.Errorf("Expected Levels() to report the default under \"*\", got %v", got)
+	}
+}
+
+func TestLevelRegistry_Replace(t *testing.T) {
+	registry := NewLevelRegistry(InfoLevel, map[string]Level{"db": DebugLevel})
+	registry.SetLevel("cache", WarnLevel)
+
+	registry.Replace(WarnLevel, map[string]Level{"payments": DebugLevel})
+
+	if got := registry.LevelFor("db"); got != WarnLevel {
+		t.Errorf("Expected removed entry to fall back to the default, got %v", got)
+	}
+	if got := registry.LevelFor("cache"); got != WarnLevel {
+		t.Errorf("Expected runtime entry to be replaced, got %v", got)
+	}
+	if got := registry.LevelFor("payments"); got != DebugLevel {
+		t.Errorf("Expected new entry to apply, got %v", got)
+	}
+}
+
+func TestLevelRegistry_ResetLevel(t *testing.T) {
+	registry := NewLevelRegistry(InfoLevel, map[string]Level{"payments": DebugLevel})
+
+	registry.SetLevel("payments", ErrorLevel)
+	registry.SetLevel("cache", WarnLevel)
+	registry.SetLevel("", ErrorLevel)
+	if got := registry.ConfiguredLevelFor("payments.refunds"); got != DebugLevel {
+		t.Errorf("Expected configured level to ignore SetLevel, got %v", got)
+	}
+
+	registry.ResetLevel("payments")
+	registry.ResetLevel("cache")
+	registry.ResetLevel("")
+
+	if got := registry.LevelFor("payments"); got != DebugLevel {
+		t.Errorf("Expected payments to return to its configured level, got %v", got)
+	}
+	if got := registry.LevelFor("cache"); got != InfoLevel {
+		t.Errorf("Expected cache to fall back to the default, got %v", got)
+	}
+	if got := registry.LevelFor("auth"); got != InfoLevel {
+		t.Errorf("Expected the default to be restored, got %v", got)
+	}
+}
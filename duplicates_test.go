@@ -0,0 +1,153 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+// logDuplicateCalls makes the same calls against every engine.
+func logDuplicateCalls(l core.Logger) {
+	l.With("user_id", 1).Infow("x", "user_id", 2, "level", "custom", "message", "m", "engine", "e")
+	l.With("user_id", 1).With("user_id", 2).Info("plain")
+	l.With("user_id", 1).WithGroup("request").With("id", 1).InfoF("grouped", fields.Int("id", 2))
+	l.Infow("malformed", 5, "v")
+}
+
+// entryPair is a top-level key and value in the order it was written.
+type entryPair struct {
+	Key   string
+	Value interface{}
+}
+
+// readEntryPairs reads the top-level pairs of each JSON entry, keeping
+// repeated keys that decoding into a map would merge.
+func readEntryPairs(t *testing.T, path string) [][]entryPair {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+
+	var entries [][]entryPair
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		dec := json.NewDecoder(strings.NewReader(line))
+		if _, err := dec.Token(); err != nil {
+			t.Fatalf("Invalid JSON %q: %v", line, err)
+		}
+		var pairs []entryPair
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				t.Fatalf("Invalid JSON %q: %v", line, err)
+			}
+			var value interface{}
+			if err := dec.Decode(&value); err != nil {
+				t.Fatalf("Invalid JSON %q: %v", line, err)
+			}
+			pairs = append(pairs, entryPair{Key: key.(string), Value: value})
+		}
+		entries = append(entries, pairs)
+	}
+	return entries
+}
+
+func TestDuplicateKeys_AcrossEngines(t *testing.T) {
+	builtin := map[string]bool{
+		fields.TimestampField: true,
+		fields.LevelField:     true,
+		fields.MessageField:   true,
+		fields.CallerField:    true,
+		"engine":              true,
+	}
+
+	tests := []struct {
+		policy string
+		want   [][]entryPair
+	}{
+		{
+			policy: "last",
+			want: [][]entryPair{
+				{{"user_id", float64(2)}, {"level_2", "custom"}, {"message_2", "m"}, {"engine_2", "e"}},
+				{{"user_id", float64(2)}},
+				{{"user_id", float64(1)}, {"request", map[string]interface{}{"id": float64(2)}}},
+				{{fields.BadKeyField, float64(5)}, {fields.BadKeyField + "_2", "v"}, {fields.MalformedField, float64(2)}},
+			},
+		},
+		{
+			policy: "first",
+			want: [][]entryPair{
+				{{"user_id", float64(1)}, {"level_2", "custom"}, {"message_2", "m"}, {"engine_2", "e"}},
+				{{"user_id", float64(1)}},
+				{{"user_id", float64(1)}, {"request", map[string]interface{}{"id": float64(1)}}},
+				{{fields.BadKeyField, float64(5)}, {fields.BadKeyField + "_2", "v"}, {fields.MalformedField, float64(2)}},
+			},
+		},
+		{
+			policy: "suffix",
+			want: [][]entryPair{
+				{{"user_id", float64(1)}, {"user_id_2", float64(2)}, {"level_2", "custom"}, {"message_2", "m"}, {"engine_2", "e"}},
+				{{"user_id", float64(1)}, {"user_id_2", float64(2)}},
+				{{"user_id", float64(1)}, {"request", map[string]interface{}{"id": float64(1), "id_2": float64(2)}}},
+				{{fields.BadKeyField, float64(5)}, {fields.BadKeyField + "_2", "v"}, {fields.MalformedField, float64(2)}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			var outputs [2][][]entryPair
+			for i, engine := range []string{"slog", "zap"} {
+				logFile := filepath.Join(t.TempDir(), "app.log")
+				opt := option.DefaultLogOption()
+				opt.Engine = engine
+				opt.OutputPaths = []string{logFile}
+				opt.DuplicateKeys = tt.policy
+
+				l, err := New(opt)
+				if err != nil {
+					t.Fatalf("%s: failed to create logger: %v", engine, err)
+				}
+				logDuplicateCalls(l)
+
+				for _, entry := range readEntryPairs(t, logFile) {
+					seen := map[string]bool{}
+					var user []entryPair
+					for _, pair := range entry {
+						if seen[pair.Key] {
+							t.Errorf("%s: key %q written twice in %v", engine, pair.Key, entry)
+						}
+						seen[pair.Key] = true
+						switch {
+						case pair.Key == "engine" && pair.Value != engine:
+							t.Errorf("%s: engine = %v, want %q", engine, pair.Value, engine)
+						case pair.Key == fields.LevelField && pair.Value != "info":
+							t.Errorf("%s: level = %v, want info", engine, pair.Value)
+						case !builtin[pair.Key]:
+							user = append(user, pair)
+						}
+					}
+					outputs[i] = append(outputs[i], user)
+				}
+			}
+
+			slogJSON, _ := json.Marshal(outputs[0])
+			zapJSON, _ := json.Marshal(outputs[1])
+			if !bytes.Equal(slogJSON, zapJSON) {
+				t.Errorf("Engines disagree\nslog: %s\nzap:  %s", slogJSON, zapJSON)
+			}
+			if !reflect.DeepEqual(outputs[0], tt.want) {
+				t.Errorf("Fields = %v, want %v", outputs[0], tt.want)
+			}
+		})
+	}
+}
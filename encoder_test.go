@@ -0,0 +1,196 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+var encoderCases = []struct {
+	name    string
+	encoder *option.EncoderOption
+	timeKey string
+	zone    *time.Location
+}{
+	{"default", nil, "timestamp", time.Local},
+	{"epoch_millis_upper_full", &option.EncoderOption{
+		TimeLayout: "epoch_millis",
+		LevelCase:  "upper",
+		Caller:     "full",
+		Duration:   "millis",
+	}, "timestamp", time.Local},
+	{"rfc3339_utc_function_keys", &option.EncoderOption{
+		TimeLayout: "rfc3339",
+		TimeZone:   "utc",
+		Caller:     "function",
+		Duration:   "string",
+		TimeKey:    "ts",
+		LevelKey:   "lvl",
+		MessageKey: "msg",
+		CallerKey:  "src",
+		NameKey:    "component",
+	}, "ts", time.UTC},
+	{"epoch_nanos_nanos", &option.EncoderOption{
+		TimeLayout: "epoch_nanos",
+		Duration:   "nanos",
+	}, "timestamp", time.Local},
+}
+
+// logEncoderCalls makes the same calls against every engine; keeping them in
+// one function gives both engines the same caller.
+func logEncoderCalls(l core.Logger) {
+	at := time.Date(2026, 10, 18, 20, 0, 0, 0, time.FixedZone("UTC+8", 8*60*60))
+	l.Named("billing").With("request_id", "r-1").Infow("charged",
+		"amount", 12.5,
+		"elapsed", 1500*time.Millisecond,
+		"at", at,
+	)
+	l.WarnF("retrying",
+		fields.Int("attempt", 2),
+		fields.Duration("backoff", 250*time.Millisecond),
+		fields.Time("next", at),
+		fields.Object("order", fields.Duration("timeout", 2*time.Second)),
+	)
+	l.Errorw("declined",
+		"error", errors.New("card expired"),
+		"elapsed", 40*time.Millisecond,
+	)
+}
+
+func TestEncoder_GoldenAcrossEngines(t *testing.T) {
+	got := map[string][]map[string]interface{}{}
+	for _, tc := range encoderCases {
+		var outputs [2][]map[string]interface{}
+		for i, engine := range []string{"slog", "zap"} {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.OutputPaths = []string{logFile}
+			opt.Encoder = tc.encoder
+
+			l, err := New(opt)
+			if err != nil {
+				t.Fatalf("%s/%s: failed to create logger: %v", tc.name, engine, err)
+			}
+			logEncoderCalls(l)
+
+			outputs[i] = readEncoderEntries(t, logFile, tc.timeKey, tc.zone)
+		}
+
+		slogJSON, _ := json.Marshal(outputs[0])
+		zapJSON, _ := json.Marshal(outputs[1])
+		if !bytes.Equal(slogJSON, zapJSON) {
+			t.Errorf("%s: engines disagree\nslog: %s\nzap:  %s", tc.name, slogJSON, zapJSON)
+		}
+		got[tc.name] = outputs[0]
+	}
+
+	golden := filepath.Join("testdata", "encoder.golden")
+	actual, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual = append(actual, '\n')
+
+	if *updateGolden {
+		if err := os.WriteFile(golden, actual, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Failed to read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(want, actual) {
+		t.Errorf("Output does not match %s (run with -update to accept)\ngot:\n%s", golden, actual)
+	}
+}
+
+// readEncoderEntries parses each JSON line, drops the engine identifier,
+// replaces the entry time with the shape of its layout after checking that it
+// is current and in zone, replaces stack traces with a marker, and trims
+// caller paths so the golden file does not depend on the clock or on where
+// the repository is checked out.
+func readEncoderEntries(t *testing.T, path, timeKey string, zone *time.Location) []map[string]interface{} {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+
+	wd, _ := os.Getwd()
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Invalid JSON %q: %v", line, err)
+		}
+		delete(entry, "engine")
+		shape, err := entryTimeShape(entry[timeKey], zone)
+		if err != nil {
+			t.Errorf("Entry time %q in %s: %v", timeKey, line, err)
+		}
+		entry[timeKey] = shape
+		if stack, ok := entry[fields.StacktraceField].(string); ok {
+			if !strings.Contains(stack, "_test.go") {
+				t.Errorf("Stack trace does not show the calling test: %q", stack)
+			}
+			entry[fields.StacktraceField] = "STACK"
+		}
+		for _, key := range []string{"caller", "src"} {
+			if caller, ok := entry[key].(string); ok {
+				caller = strings.TrimPrefix(caller, wd+"/")
+				entry[key] = strings.TrimPrefix(caller, filepath.Base(wd)+"/")
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// entryTimeShape checks that an entry time was written within the last
+// minute, in zone for formatted times, and returns the name of its layout.
+func entryTimeShape(value interface{}, zone *time.Location) (string, error) {
+	var at time.Time
+	var shape string
+	switch v := value.(type) {
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return "", err
+		}
+		_, offset := parsed.Zone()
+		if _, want := parsed.In(zone).Zone(); offset != want {
+			return "", fmt.Errorf("offset %ds, want %ds for %s", offset, want, zone)
+		}
+		at, shape = parsed, "RFC3339"
+		if strings.Contains(v, ".") {
+			shape = "RFC3339Nano"
+		}
+	case float64:
+		// Nanosecond epochs lose precision as float64, which the window absorbs
+		if v > 1e15 {
+			at, shape = time.Unix(0, int64(v)), "EPOCH_NANOS"
+		} else {
+			at, shape = time.UnixMilli(int64(v)), "EPOCH_MILLIS"
+		}
+	default:
+		return "", fmt.Errorf("missing")
+	}
+	if age := time.Since(at); age < -time.Second || age > time.Minute {
+		return "", fmt.Errorf("%s is not the current time", at)
+	}
+	return shape, nil
+}
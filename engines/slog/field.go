@@ -0,0 +1,122 @@
+package slog
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+)
+
+// toSlogAttrs converts typed fields to slog attributes, expanding top-level
+// errors, resolving marshalers, standardizing top-level keys and reserving
+// room for extra attributes.
+func (l *SlogLogger) toSlogAttrs(fs []fields.Field, extra int) []slog.Attr {
+	fs = fields.ResolveMarshalers(fields.ExpandErrors(fs))
+	attrs := make([]slog.Attr, 0, len(fs)+extra)
+	for _, f := range fs {
+		if f.Type == fields.SkipType {
+			continue
+		}
+		attrs = append(attrs, toSlogAttr(l.getStandardFieldName(f.Key), f))
+	}
+	return attrs
+}
+
+// toSlogAttr maps a typed field to the equivalent slog attribute. Objects
+// become groups; arrays and AnyType values use slog.AnyValue.
+func toSlogAttr(key string, f fields.Field) slog.Attr {
+	switch f.Type {
+	case fields.StringType:
+		return slog.String(key, f.String)
+	case fields.Int64Type:
+		return slog.Int64(key, f.Integer)
+	case fields.Uint64Type:
+		return slog.Uint64(key, uint64(f.Integer))
+	case fields.Float64Type:
+		return slog.Float64(key, f.Float64Value())
+	case fields.BoolType:
+		return slog.Bool(key, f.Integer == 1)
+	case fields.DurationType:
+		return slog.Duration(key, time.Duration(f.Integer))
+	case fields.TimeType:
+		return slog.Time(key, f.TimeValue())
+	case fields.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return slog.String(key, err.Error())
+		}
+		return slog.Any(key, f.Interface)
+	case fields.ObjectType:
+		nested := f.Fields()
+		attrs := make([]slog.Attr, 0, len(nested))
+		for _, n := range nested {
+			if n.Type != fields.SkipType {
+				attrs = append(attrs, toSlogAttr(n.Key, n))
+			}
+		}
+		return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+	case fields.ArrayType:
+		return slog.Any(key, f.Value())
+	default:
+		return slog.Any(key, f.Interface)
+	}
+}
+
+// sendFieldsToOTLP sends a typed-field log record to OTLP.
+func (l *SlogLogger) sendFieldsToOTLP(level core.Level, msg string, fs []fields.Field) {
+	if l.otlpProvider == nil {
+		return
+	}
+	fs = fields.ResolveMarshalers(fields.ExpandErrors(fs))
+
+	keys := make([]string, len(fs))
+	for i, f := range fs {
+		if f.Type != fields.SkipType {
+			keys[i] = l.getStandardFieldName(f.Key)
+		}
+	}
+	// Grouped attributes are nested, where the reserved keys do not apply
+	if len(l.groups) == 0 {
+		keys = l.keys.Resolve(keys)
+	} else {
+		keys = l.keys.ResolveNested(keys)
+	}
+
+	attributes := make(map[string]interface{}, len(fs))
+	for i, f := range fs {
+		if keys[i] != "" {
+			attributes[keys[i]] = f.Value()
+		}
+	}
+
+	attributes = l.otlpProvider.GroupAttributes(l.groupNames(), attributes)
+
+	// Send log record to OTLP
+	if err := l.otlpProvider.SendLogRecord(level, msg, attributes); err != nil {
+		// Log the error to stderr without causing recursion
+		fmt.Printf("OTLP export error: %v\n", err)
+	}
+}
+
+// encodeTime encodes a time value the way the zap engine's EncodeTime does.
+func encodeTime(encoder *fields.EncoderConfig, t time.Time) slog.Value {
+	if epoch, ok := encoder.EpochTime(t); ok {
+		return slog.Int64Value(epoch)
+	}
+	return slog.StringValue(encoder.FormatTime(t))
+}
+
+// encodeDuration encodes a duration value the way the zap engine's EncodeDuration does.
+func encodeDuration(encoder *fields.EncoderConfig, d time.Duration) slog.Value {
+	switch encoder.DurationFormat {
+	case fields.MillisDurationFormatter:
+		return slog.Float64Value(float64(d) / float64(time.Millisecond))
+	case fields.NanosDurationFormatter:
+		return slog.Int64Value(int64(d))
+	case fields.StringDurationFormatter:
+		return slog.StringValue(d.String())
+	default:
+		return slog.Float64Value(float64(d) / float64(time.Second))
+	}
+}
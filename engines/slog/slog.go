@@ -16,13 +16,35 @@ import (
 )
 
 // SlogLogger implements the core.Logger interface using Go's standard slog library.
+// Attributes added by With are kept in context rather than in the handler of
+// logger so that they can be deduplicated against the fields of each entry.
 type SlogLogger struct {
 	logger            *slog.Logger
-	level             core.Level
+	context           []slog.Attr
+	keys              *fields.KeyResolver
+	levels            *core.LevelRegistry
+	name              string
 	mapper            *fields.FieldMapper
+	encoder           *fields.EncoderConfig
 	callerSkip        int
 	disableStacktrace bool
 	otlpProvider      *otlp.LoggerProvider
+	groups            []attrGroup
+	limits            *fields.Limits
+	usage             fields.ContextUsage
+
+	// plain is logger with the context attributes and those added inside
+	// open groups, for the methods that take no fields of their own
+	plain *slog.Logger
+}
+
+// attrGroup is an open group together with the attributes added inside it by
+// With. Groups are applied when an entry is written rather than through the
+// handler so that the engine, logger name, caller and stacktrace stay at the
+// top level.
+type attrGroup struct {
+	name  string
+	attrs []slog.Attr
 }
 
 // NewSlogLogger creates a new Slog-based logger with the provided configuration.
@@ -53,18 +75,61 @@ func NewSlogLogger(opt *option.LogOption) (core.Logger, error) {
 		return nil, err
 	}
 
+	// Per-component levels are resolved by name at runtime
+	namedLevels, err := opt.ParseLevels()
+	if err != nil {
+		return nil, err
+	}
+	levels := core.NewLevelRegistry(level, namedLevels)
+
+	// Field names come from the configured naming scheme
+	mapper, err := opt.FieldMapper()
+	if err != nil {
+		return nil, err
+	}
+	encoder, err := opt.EncoderConfig()
+	if err != nil {
+		return nil, err
+	}
+	limits, err := opt.RecordLimits()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := opt.KeyResolver()
+	if err != nil {
+		return nil, err
+	}
+	if otlpProvider != nil {
+		otlpProvider.SetLimits(limits)
+		otlpProvider.SetFieldMapper(mapper)
+	}
+
 	// Create handler options - we handle caller manually for consistent formatting
+	// The inner handler accepts every level; standardizedHandler applies the
+	// effective level for each logger name.
 	handlerOpts := &slog.HandlerOptions{
-		Level:     mapToSlogLevel(level),
+		Level:     slog.LevelDebug,
 		AddSource: false, // We'll add standardized caller field ourselves
 		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
-			// Convert level to lowercase for consistent formatting
-			if attr.Key == slog.LevelKey {
+			// Encode through the shared encoder configuration so zap output matches
+			switch attr.Value.Kind() {
+			case slog.KindTime:
+				attr.Value = encodeTime(encoder, attr.Value.Time())
+			case slog.KindDuration:
+				attr.Value = encodeDuration(encoder, attr.Value.Duration())
+			}
+			if len(groups) > 0 {
+				return attr
+			}
+			switch attr.Key {
+			case slog.TimeKey:
+				attr.Key = mapper.StandardName(fields.TimestampField)
+			case slog.MessageKey:
+				attr.Key = mapper.StandardName(fields.MessageField)
+			case slog.LevelKey:
+				attr.Key = mapper.StandardName(fields.LevelField)
 				if level, ok := attr.Value.Any().(slog.Level); ok {
-					return slog.Attr{
-						Key:   attr.Key,
-						Value: slog.StringValue(strings.ToLower(level.String())),
-					}
+					attr.Value = slog.StringValue(encoder.FormatLevel(level.String()))
 				}
 			}
 			return attr
@@ -85,47 +150,53 @@ func NewSlogLogger(opt *option.LogOption) (core.Logger, error) {
 	// Create standardized handler wrapper for field consistency
 	standardHandler := &standardizedHandler{
 		handler:           handler,
-		mapper:            fields.NewFieldMapper(),
+		mapper:            mapper,
+		levels:            levels,
 		disableCaller:     opt.DisableCaller,
 		disableStacktrace: opt.DisableStacktrace,
+		limits:            limits,
 	}
 
 	logger := slog.New(standardHandler)
 
 	return &SlogLogger{
 		logger:            logger,
-		level:             level,
-		mapper:            fields.NewFieldMapper(),
+		keys:              keys,
+		levels:            levels,
+		mapper:            mapper,
+		encoder:           encoder,
 		callerSkip:        0,
 		disableStacktrace: opt.DisableStacktrace,
 		otlpProvider:      otlpProvider,
+		limits:            limits,
+		plain:             logger,
 	}, nil
 }
 
 // Debug logs a debug message.
 func (l *SlogLogger) Debug(args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Debug(formatArgs(args...), slog.String(fields.CallerField, caller))
+		l.plain.Debug(formatArgs(args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Debug(formatArgs(args...))
+		l.plain.Debug(formatArgs(args...))
 	}
 }
 
 // Info logs an info message.
 func (l *SlogLogger) Info(args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Info(formatArgs(args...), slog.String(fields.CallerField, caller))
+		l.plain.Info(formatArgs(args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Info(formatArgs(args...))
+		l.plain.Info(formatArgs(args...))
 	}
 }
 
 // Warn logs a warning message.
 func (l *SlogLogger) Warn(args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Warn(formatArgs(args...), slog.String(fields.CallerField, caller))
+		l.plain.Warn(formatArgs(args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Warn(formatArgs(args...))
+		l.plain.Warn(formatArgs(args...))
 	}
 }
 
@@ -142,7 +213,7 @@ func (l *SlogLogger) Error(args ...interface{}) {
 		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
 	}
 	
-	l.logger.Error(formatArgs(args...), attrs...)
+	l.plain.Error(formatArgs(args...), attrs...)
 }
 
 // Fatal logs a fatal message and exits.
@@ -158,34 +229,34 @@ func (l *SlogLogger) Fatal(args ...interface{}) {
 		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
 	}
 	
-	l.logger.Error(formatArgs(args...), attrs...)
+	l.plain.Error(formatArgs(args...), attrs...)
 	os.Exit(1)
 }
 
 // Debugf logs a formatted debug message.
 func (l *SlogLogger) Debugf(template string, args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Debug(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
+		l.plain.Debug(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Debug(fmt.Sprintf(template, args...))
+		l.plain.Debug(fmt.Sprintf(template, args...))
 	}
 }
 
 // Infof logs a formatted info message.
 func (l *SlogLogger) Infof(template string, args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Info(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
+		l.plain.Info(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Info(fmt.Sprintf(template, args...))
+		l.plain.Info(fmt.Sprintf(template, args...))
 	}
 }
 
 // Warnf logs a formatted warning message.
 func (l *SlogLogger) Warnf(template string, args ...interface{}) {
 	if caller := l.getCaller(); caller != "" {
-		l.logger.Warn(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
+		l.plain.Warn(fmt.Sprintf(template, args...), slog.String(fields.CallerField, caller))
 	} else {
-		l.logger.Warn(fmt.Sprintf(template, args...))
+		l.plain.Warn(fmt.Sprintf(template, args...))
 	}
 }
 
@@ -202,7 +273,7 @@ func (l *SlogLogger) Errorf(template string, args ...interface{}) {
 		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
 	}
 	
-	l.logger.Error(fmt.Sprintf(template, args...), attrs...)
+	l.plain.Error(fmt.Sprintf(template, args...), attrs...)
 }
 
 // Fatalf logs a formatted fatal message and exits.
@@ -218,60 +289,89 @@ func (l *SlogLogger) Fatalf(template string, args ...interface{}) {
 		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
 	}
 	
-	l.logger.Error(fmt.Sprintf(template, args...), attrs...)
+	l.plain.Error(fmt.Sprintf(template, args...), attrs...)
 	os.Exit(1)
 }
 
 // Debugw logs a debug message with structured fields.
 func (l *SlogLogger) Debugw(msg string, keysAndValues ...interface{}) {
-	attrs := l.convertToSlogAttrs(keysAndValues...)
-	if caller := l.getCaller(); caller != "" {
-		attrs = append(attrs, slog.String(fields.CallerField, caller))
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelDebug) {
+		return
+	}
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	if l.logger.Enabled(ctx, slog.LevelDebug) {
+		attrs := l.entryAttrs(fs, 2)
+		if caller := l.getCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
 	}
-	l.logger.DebugContext(context.Background(), msg, attrs...)
-	l.sendToOTLP(core.DebugLevel, msg, keysAndValues...)
+	l.sendFieldsToOTLP(core.DebugLevel, msg, fs)
 }
 
 // Infow logs an info message with structured fields.
 func (l *SlogLogger) Infow(msg string, keysAndValues ...interface{}) {
-	attrs := l.convertToSlogAttrs(keysAndValues...)
-	if caller := l.getCaller(); caller != "" {
-		attrs = append(attrs, slog.String(fields.CallerField, caller))
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelInfo) {
+		return
+	}
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	if l.logger.Enabled(ctx, slog.LevelInfo) {
+		attrs := l.entryAttrs(fs, 2)
+		if caller := l.getCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelInfo, msg, attrs...)
 	}
-	l.logger.InfoContext(context.Background(), msg, attrs...)
-	l.sendToOTLP(core.InfoLevel, msg, keysAndValues...)
+	l.sendFieldsToOTLP(core.InfoLevel, msg, fs)
 }
 
 // Warnw logs a warning message with structured fields.
 func (l *SlogLogger) Warnw(msg string, keysAndValues ...interface{}) {
-	attrs := l.convertToSlogAttrs(keysAndValues...)
-	if caller := l.getCaller(); caller != "" {
-		attrs = append(attrs, slog.String(fields.CallerField, caller))
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelWarn) {
+		return
 	}
-	l.logger.WarnContext(context.Background(), msg, attrs...)
-	l.sendToOTLP(core.WarnLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	if l.logger.Enabled(ctx, slog.LevelWarn) {
+		attrs := l.entryAttrs(fs, 2)
+		if caller := l.getCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelWarn, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.WarnLevel, msg, fs)
 }
 
 // Errorw logs an error message with structured fields.
 func (l *SlogLogger) Errorw(msg string, keysAndValues ...interface{}) {
-	attrs := l.convertToSlogAttrs(keysAndValues...)
-	
-	if caller := l.getCaller(); caller != "" {
-		attrs = append(attrs, slog.String(fields.CallerField, caller))
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelError) {
+		return
 	}
-	
-	// Add stacktrace for error level
-	if stacktrace := l.getStacktrace(); stacktrace != "" {
-		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	if l.logger.Enabled(ctx, slog.LevelError) {
+		attrs := l.entryAttrs(fs, 2)
+
+		if caller := l.getCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+
+		// Add stacktrace for error level
+		if stacktrace := l.getStacktrace(); stacktrace != "" {
+			attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
+		}
+
+		l.logger.LogAttrs(ctx, slog.LevelError, msg, attrs...)
 	}
-	
-	l.logger.ErrorContext(context.Background(), msg, attrs...)
-	l.sendToOTLP(core.ErrorLevel, msg, keysAndValues...)
+	l.sendFieldsToOTLP(core.ErrorLevel, msg, fs)
 }
 
 // Fatalw logs a fatal message with structured fields and exits.
 func (l *SlogLogger) Fatalw(msg string, keysAndValues ...interface{}) {
-	attrs := l.convertToSlogAttrs(keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	attrs := l.entryAttrs(fs, 2)
 	
 	if caller := l.getCaller(); caller != "" {
 		attrs = append(attrs, slog.String(fields.CallerField, caller))
@@ -282,35 +382,142 @@ func (l *SlogLogger) Fatalw(msg string, keysAndValues ...interface{}) {
 		attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
 	}
 	
-	l.logger.ErrorContext(context.Background(), msg, attrs...)
-	l.sendToOTLP(core.FatalLevel, msg, keysAndValues...)
+	l.logger.LogAttrs(context.Background(), slog.LevelError, msg, attrs...)
+	l.sendFieldsToOTLP(core.FatalLevel, msg, fs)
+	os.Exit(1)
+}
+
+// DebugF logs a debug message with typed fields.
+func (l *SlogLogger) DebugF(msg string, fs ...fields.Field) {
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelDebug) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	if l.logger.Enabled(ctx, slog.LevelDebug) {
+		attrs := l.entryAttrs(fs, 1)
+		if caller := l.typedCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.DebugLevel, msg, fs)
+}
+
+// InfoF logs an info message with typed fields.
+func (l *SlogLogger) InfoF(msg string, fs ...fields.Field) {
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelInfo) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	if l.logger.Enabled(ctx, slog.LevelInfo) {
+		attrs := l.entryAttrs(fs, 1)
+		if caller := l.typedCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelInfo, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.InfoLevel, msg, fs)
+}
+
+// WarnF logs a warning message with typed fields.
+func (l *SlogLogger) WarnF(msg string, fs ...fields.Field) {
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelWarn) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	if l.logger.Enabled(ctx, slog.LevelWarn) {
+		attrs := l.entryAttrs(fs, 1)
+		if caller := l.typedCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelWarn, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.WarnLevel, msg, fs)
+}
+
+// ErrorF logs an error message with typed fields.
+func (l *SlogLogger) ErrorF(msg string, fs ...fields.Field) {
+	ctx := context.Background()
+	if !l.shouldLog(ctx, slog.LevelError) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	if l.logger.Enabled(ctx, slog.LevelError) {
+		attrs := l.entryAttrs(fs, 2)
+		if caller := l.typedCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		if stacktrace := l.getStacktrace(); stacktrace != "" {
+			attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelError, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.ErrorLevel, msg, fs)
+}
+
+// FatalF logs a fatal message with typed fields and exits.
+func (l *SlogLogger) FatalF(msg string, fs ...fields.Field) {
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	ctx := context.Background()
+	if l.logger.Enabled(ctx, slog.LevelError) {
+		attrs := l.entryAttrs(fs, 2)
+		if caller := l.typedCaller(); caller != "" {
+			attrs = append(attrs, slog.String(fields.CallerField, caller))
+		}
+		if stacktrace := l.getStacktrace(); stacktrace != "" {
+			attrs = append(attrs, slog.String(fields.StacktraceField, stacktrace))
+		}
+		l.logger.LogAttrs(ctx, slog.LevelError, msg, attrs...)
+	}
+	l.sendFieldsToOTLP(core.FatalLevel, msg, fs)
 	os.Exit(1)
 }
 
 // With creates a child logger with the specified key-value pairs.
 func (l *SlogLogger) With(keysAndValues ...interface{}) core.Logger {
-	newLogger := l.logger.With(l.convertToSlogAttrs(keysAndValues...)...)
+	attrs, usage := l.convertToSlogAttrs(keysAndValues...)
+	context, groups := l.withAttrs(attrs)
 	return &SlogLogger{
-		logger:            newLogger,
-		level:             l.level,
+		logger:            l.logger,
+		context:           context,
+		keys:              l.keys,
+		levels:            l.levels,
+		name:              l.name,
 		mapper:            l.mapper,
+		encoder:           l.encoder,
 		callerSkip:        l.callerSkip,
 		disableStacktrace: l.disableStacktrace,
 		otlpProvider:      l.otlpProvider,
+		limits:            l.limits,
+		usage:             usage,
+		groups:            groups,
+		plain:             withContext(l.logger, l.keys, context, groups, l.markerAttrs(usage)),
 	}
 }
 
 // WithCtx creates a child logger with context and key-value pairs.
 func (l *SlogLogger) WithCtx(ctx context.Context, keysAndValues ...interface{}) core.Logger {
 	// Slog doesn't have a direct equivalent, so we'll create a logger with the fields
-	newLogger := l.logger.With(l.convertToSlogAttrs(keysAndValues...)...)
+	attrs, usage := l.convertToSlogAttrs(keysAndValues...)
+	context, groups := l.withAttrs(attrs)
 	return &SlogLogger{
-		logger:            newLogger,
-		level:             l.level,
+		logger:            l.logger,
+		context:           context,
+		keys:              l.keys,
+		levels:            l.levels,
+		name:              l.name,
 		mapper:            l.mapper,
+		encoder:           l.encoder,
 		callerSkip:        l.callerSkip,
 		disableStacktrace: l.disableStacktrace,
 		otlpProvider:      l.otlpProvider,
+		limits:            l.limits,
+		usage:             usage,
+		groups:            groups,
+		plain:             withContext(l.logger, l.keys, context, groups, l.markerAttrs(usage)),
 	}
 }
 
@@ -318,19 +525,112 @@ func (l *SlogLogger) WithCtx(ctx context.Context, keysAndValues ...interface{})
 func (l *SlogLogger) WithCallerSkip(skip int) core.Logger {
 	return &SlogLogger{
 		logger:            l.logger,
-		level:             l.level,
+		context:           l.context,
+		keys:              l.keys,
+		levels:            l.levels,
+		name:              l.name,
 		mapper:            l.mapper,
+		encoder:           l.encoder,
 		callerSkip:        l.callerSkip + skip,
 		disableStacktrace: l.disableStacktrace,
 		otlpProvider:      l.otlpProvider,
+		limits:            l.limits,
+		usage:             l.usage,
+		groups:            l.groups,
+		plain:             l.plain,
+	}
+}
+
+// Named creates a child logger with the given name appended to the parent's.
+func (l *SlogLogger) Named(name string) core.Logger {
+	fullName := name
+	if l.name != "" && name != "" {
+		fullName = l.name + "." + name
+	} else if name == "" {
+		fullName = l.name
+	}
+
+	handler := l.logger.Handler()
+	if standard, ok := handler.(*standardizedHandler); ok {
+		named := *standard
+		named.name = fullName
+		handler = &named
+	}
+
+	newLogger := slog.New(handler)
+	return &SlogLogger{
+		logger:            newLogger,
+		context:           l.context,
+		keys:              l.keys,
+		levels:            l.levels,
+		name:              fullName,
+		mapper:            l.mapper,
+		encoder:           l.encoder,
+		callerSkip:        l.callerSkip,
+		disableStacktrace: l.disableStacktrace,
+		otlpProvider:      l.otlpProvider,
+		limits:            l.limits,
+		usage:             l.usage,
+		groups:            l.groups,
+		plain:             withContext(newLogger, l.keys, l.context, l.groups, l.markerAttrs(l.usage)),
+	}
+}
+
+// WithGroup creates a child logger that nests later fields under name.
+func (l *SlogLogger) WithGroup(name string) core.Logger {
+	if name == "" {
+		return l
+	}
+	// Only the outermost group is a top-level key subject to field naming
+	if len(l.groups) == 0 {
+		name = l.getStandardFieldName(name)
+	}
+
+	return &SlogLogger{
+		logger:            l.logger,
+		context:           l.context,
+		keys:              l.keys,
+		levels:            l.levels,
+		name:              l.name,
+		mapper:            l.mapper,
+		encoder:           l.encoder,
+		callerSkip:        l.callerSkip,
+		disableStacktrace: l.disableStacktrace,
+		otlpProvider:      l.otlpProvider,
+		limits:            l.limits,
+		usage:             l.usage,
+		groups:            append(append([]attrGroup{}, l.groups...), attrGroup{name: name}),
+		plain:             l.plain,
 	}
 }
 
-// SetLevel sets the minimum logging level.
+// SetLevel sets the minimum logging level for this logger's name.
+// The level is shared with every logger of the same name derived from the same root,
+// and on the root logger it becomes the default for all names without their own level.
 func (l *SlogLogger) SetLevel(level core.Level) {
-	l.level = level
-	// Note: slog doesn't support dynamic level changes easily
-	// This would require recreating the handler with new options
+	l.levels.SetLevel(l.name, level)
+}
+
+// SetNamedLevels replaces the default and per-component levels at runtime.
+func (l *SlogLogger) SetNamedLevels(defaultLevel core.Level, levels map[string]core.Level) {
+	l.levels.Replace(defaultLevel, levels)
+}
+
+// ConfiguredLevel returns the level configured for this logger's name,
+// ignoring levels set with SetLevel.
+func (l *SlogLogger) ConfiguredLevel() core.Level {
+	return l.levels.ConfiguredLevelFor(l.name)
+}
+
+// ResetLevel drops a level set with SetLevel for this logger's name, so the
+// logger follows its configured level again.
+func (l *SlogLogger) ResetLevel() {
+	l.levels.ResetLevel(l.name)
+}
+
+// Enabled reports whether entries at level are written for this logger's name.
+func (l *SlogLogger) Enabled(level core.Level) bool {
+	return level >= l.levels.LevelFor(l.name)
 }
 
 // Helper functions
@@ -365,42 +665,115 @@ func anyToString(v interface{}) string {
 	return slog.AnyValue(v).String()
 }
 
-func (l *SlogLogger) convertToSlogAttrs(keysAndValues ...interface{}) []interface{} {
-	attrs := make([]interface{}, 0, len(keysAndValues))
-	
-	for i := 0; i < len(keysAndValues); i += 2 {
-		if i+1 >= len(keysAndValues) {
-			// Odd number of arguments, use empty value for last key
-			attrs = append(attrs, slog.Any(anyToString(keysAndValues[i]), nil))
-			break
-		}
-		
-		key := anyToString(keysAndValues[i])
-		value := keysAndValues[i+1]
-		
-		// Apply field mapping for consistency
-		if mappedKey := l.getStandardFieldName(key); mappedKey != "" {
-			key = mappedKey
+// withAttrs adds attrs to the context, or inside the innermost open group.
+func (l *SlogLogger) withAttrs(attrs []slog.Attr) ([]slog.Attr, []attrGroup) {
+	if len(l.groups) == 0 {
+		return resolveAttrs(append(append([]slog.Attr{}, l.context...), attrs...), l.keys.Resolve), nil
+	}
+
+	// Attributes added inside a group are nested when each entry is written
+	groups := append([]attrGroup{}, l.groups...)
+	last := &groups[len(groups)-1]
+	last.attrs = resolveAttrs(append(append([]slog.Attr{}, last.attrs...), attrs...), l.keys.ResolveNested)
+	return l.context, groups
+}
+
+// entryAttrs returns the context attributes followed by the attributes of fs
+// nested under the open groups, with duplicate keys resolved. extra reserves
+// room for the caller and stacktrace.
+func (l *SlogLogger) entryAttrs(fs []fields.Field, extra int) []slog.Attr {
+	if len(l.context) == 0 && len(l.groups) == 0 {
+		return resolveAttrs(l.toSlogAttrs(fs, extra), l.keys.Resolve)
+	}
+	grouped := l.grouped(l.toSlogAttrs(fs, 0))
+	attrs := make([]slog.Attr, 0, len(l.context)+len(grouped)+extra)
+	return resolveAttrs(append(append(attrs, l.context...), grouped...), l.keys.Resolve)
+}
+
+// grouped nests attrs, after the attributes added inside each open group,
+// under the open groups. Groups left without attributes are omitted.
+func (l *SlogLogger) grouped(attrs []slog.Attr) []slog.Attr {
+	return nestAttrs(l.groups, attrs, l.keys)
+}
+
+func nestAttrs(groups []attrGroup, attrs []slog.Attr, keys *fields.KeyResolver) []slog.Attr {
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		inner := resolveAttrs(append(append([]slog.Attr{}, g.attrs...), attrs...), keys.ResolveNested)
+		attrs = nil
+		if len(inner) > 0 {
+			attrs = []slog.Attr{{Key: g.name, Value: slog.GroupValue(inner...)}}
 		}
-		
-		attrs = append(attrs, slog.Any(key, value))
 	}
-	
 	return attrs
 }
 
-func (l *SlogLogger) getStandardFieldName(fieldName string) string {
-	coreMapping := l.mapper.MapCoreFields()
-	if mapped, exists := coreMapping[fieldName]; exists {
-		return mapped
+// groupNames returns the names of the open groups, outermost first.
+func (l *SlogLogger) groupNames() []string {
+	if len(l.groups) == 0 {
+		return nil
 	}
-	
-	tracingMapping := l.mapper.MapTracingFields()
-	if mapped, exists := tracingMapping[fieldName]; exists {
-		return mapped
+	names := make([]string, len(l.groups))
+	for i, g := range l.groups {
+		names[i] = g.name
 	}
-	
-	return fieldName // Return original if no mapping found
+	return names
+}
+
+// resolveAttrs drops or renames the attributes of attrs whose keys repeat,
+// using resolve to apply the duplicate key policy.
+func resolveAttrs(attrs []slog.Attr, resolve func([]string) []string) []slog.Attr {
+	if len(attrs) == 0 {
+		return attrs
+	}
+	keys := make([]string, len(attrs))
+	for i, attr := range attrs {
+		keys[i] = attr.Key
+	}
+	resolved := resolve(keys)
+	if &resolved[0] == &keys[0] {
+		return attrs
+	}
+	kept := make([]slog.Attr, 0, cap(attrs))
+	for i, attr := range attrs {
+		if resolved[i] == "" && attr.Key != "" {
+			continue
+		}
+		attr.Key = resolved[i]
+		kept = append(kept, attr)
+	}
+	return kept
+}
+
+// withContext returns logger with the context attributes, those added inside
+// open groups and the marker of what limits cut from them, for the methods
+// that take no fields of their own.
+func withContext(logger *slog.Logger, keys *fields.KeyResolver, context []slog.Attr, groups []attrGroup, marker []slog.Attr) *slog.Logger {
+	attrs := append(append([]slog.Attr{}, context...), nestAttrs(groups, nil, keys)...)
+	if attrs := resolveAttrs(append(attrs, marker...), keys.Resolve); len(attrs) > 0 {
+		return slog.New(logger.Handler().WithAttrs(attrs))
+	}
+	return logger
+}
+
+// convertToSlogAttrs normalizes key-value pairs added by With into limited
+// slog attributes with standard keys, and returns the usage of the resulting
+// context.
+func (l *SlogLogger) convertToSlogAttrs(keysAndValues ...interface{}) ([]slog.Attr, fields.ContextUsage) {
+	fs, usage := l.limits.ApplyContext(l.usage, fields.Normalize(keysAndValues...))
+	return l.toSlogAttrs(fs, 0), usage
+}
+
+// markerAttrs returns the marker of what limits cut from the context with usage.
+func (l *SlogLogger) markerAttrs(usage fields.ContextUsage) []slog.Attr {
+	if marker, ok := usage.Marker(); ok {
+		return l.toSlogAttrs([]fields.Field{marker}, 0)
+	}
+	return nil
+}
+
+func (l *SlogLogger) getStandardFieldName(fieldName string) string {
+	return l.mapper.StandardName(fieldName)
 }
 
 func mapToSlogLevel(level core.Level) slog.Level {
@@ -452,20 +825,29 @@ func createOutputWriters(paths []string) (io.Writer, error) {
 type standardizedHandler struct {
 	handler            slog.Handler
 	mapper             *fields.FieldMapper
+	levels             *core.LevelRegistry
+	name               string
 	disableCaller      bool
 	disableStacktrace  bool
+	limits             *fields.Limits
 }
 
 func (h *standardizedHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.levels != nil && level < mapToSlogLevel(h.levels.LevelFor(h.name)) {
+		return false
+	}
 	return h.handler.Enabled(ctx, level)
 }
 
 func (h *standardizedHandler) Handle(ctx context.Context, record slog.Record) error {
+	// Messages formatted without going through fields.Limits.Apply are cut here
+	msg, marker := h.limits.TruncateMessage(record.Message)
+
 	// Create a new record with standardized field names
 	newRecord := slog.Record{
 		Time:    record.Time,
 		Level:   record.Level,
-		Message: record.Message,
+		Message: msg,
 		PC:      record.PC,
 	}
 	
@@ -474,6 +856,9 @@ func (h *standardizedHandler) Handle(ctx context.Context, record slog.Record) er
 		Key:   "engine",
 		Value: slog.StringValue("slog"),
 	})
+	if h.name != "" {
+		newRecord.AddAttrs(slog.String(h.getStandardFieldName(fields.LoggerField), h.name))
+	}
 	
 	
 	// Map user-defined fields using our field standardization system
@@ -485,6 +870,9 @@ func (h *standardizedHandler) Handle(ctx context.Context, record slog.Record) er
 		})
 		return true
 	})
+	for _, f := range marker {
+		newRecord.AddAttrs(toSlogAttr(h.getStandardFieldName(f.Key), f))
+	}
 	
 	return h.handler.Handle(ctx, newRecord)
 }
@@ -500,8 +888,11 @@ func (h *standardizedHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	return &standardizedHandler{
 		handler:           h.handler.WithAttrs(standardizedAttrs),
 		mapper:            h.mapper,
+		levels:            h.levels,
+		name:              h.name,
 		disableCaller:     h.disableCaller,
 		disableStacktrace: h.disableStacktrace,
+		limits:            h.limits,
 	}
 }
 
@@ -509,24 +900,37 @@ func (h *standardizedHandler) WithGroup(name string) slog.Handler {
 	return &standardizedHandler{
 		handler:           h.handler.WithGroup(name),
 		mapper:            h.mapper,
+		levels:            h.levels,
+		name:              h.name,
 		disableCaller:     h.disableCaller,
 		disableStacktrace: h.disableStacktrace,
+		limits:            h.limits,
 	}
 }
 
 
 func (h *standardizedHandler) getStandardFieldName(fieldName string) string {
-	coreMapping := h.mapper.MapCoreFields()
-	if mapped, exists := coreMapping[fieldName]; exists {
-		return mapped
-	}
-	
-	tracingMapping := h.mapper.MapTracingFields()
-	if mapped, exists := tracingMapping[fieldName]; exists {
-		return mapped
+	return h.mapper.StandardName(fieldName)
+}
+
+// shouldLog reports whether an entry at level is written anywhere, so that
+// disabled entries skip normalization, limits and caller detection. OTLP
+// export does not depend on the local level.
+func (l *SlogLogger) shouldLog(ctx context.Context, level slog.Level) bool {
+	return l.otlpProvider != nil || l.logger.Enabled(ctx, level)
+}
+
+// typedCaller returns the caller of a typed-field method. The package-level
+// typed functions call these methods through a logger that already skips
+// their frame, so unlike getCaller it does not search the stack for them.
+func (l *SlogLogger) typedCaller() string {
+	var pcs [1]uintptr
+	if runtime.Callers(3+l.callerSkip, pcs[:]) > 0 {
+		if f, _ := runtime.CallersFrames(pcs[:]).Next(); f.File != "" {
+			return l.encoder.FormatCaller(f.File, f.Line, f.Function)
+		}
 	}
-	
-	return fieldName // Return original if no mapping found
+	return ""
 }
 
 // getCaller returns the caller information for the SlogLogger
@@ -568,15 +972,7 @@ func (l *SlogLogger) getCaller() string {
 	if runtime.Callers(skip, pcs2[:]) > 0 {
 		fs2 := runtime.CallersFrames(pcs2[:1])
 		if f, _ := fs2.Next(); f.File != "" {
-			// Extract just the filename from the full path
-			file := f.File
-			if idx := strings.LastIndex(file, "/"); idx >= 0 {
-				if idx2 := strings.LastIndex(file[:idx], "/"); idx2 >= 0 {
-					file = file[idx2+1:] // Keep last two path segments
-				}
-			}
-			
-			return fmt.Sprintf("%s:%d", file, f.Line)
+			return l.encoder.FormatCaller(f.File, f.Line, f.Function)
 		}
 	}
 	
@@ -630,32 +1026,3 @@ func (l *SlogLogger) getStacktrace() string {
 	
 	return stackTrace.String()
 }
-
-// sendToOTLP sends log data to OTLP as a log record.
-func (l *SlogLogger) sendToOTLP(level core.Level, msg string, keysAndValues ...interface{}) {
-	if l.otlpProvider == nil {
-		return
-	}
-
-	// Convert keysAndValues to map
-	attributes := make(map[string]interface{})
-	
-	for i := 0; i < len(keysAndValues); i += 2 {
-		if i+1 >= len(keysAndValues) {
-			break
-		}
-		
-		key := anyToString(keysAndValues[i])
-		value := keysAndValues[i+1]
-		
-		// Apply field mapping
-		standardKey := l.getStandardFieldName(key)
-		attributes[standardKey] = value
-	}
-
-	// Send log record to OTLP
-	if err := l.otlpProvider.SendLogRecord(level, msg, attributes); err != nil {
-		// Log the error to stderr without causing recursion
-		fmt.Printf("OTLP export error: %v\n", err)
-	}
-}
\ No newline at end of file
@@ -1,6 +1,9 @@
 package slog
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/kart-io/logger/core"
@@ -25,8 +28,8 @@ func TestNewSlogLogger(t *testing.T) {
 		t.Fatal("NewSlogLogger() didn't return *SlogLogger")
 	}
 
-	if slogLogger.level != core.InfoLevel {
-		t.Errorf("Expected level to be InfoLevel, got %v", slogLogger.level)
+	if slogLogger.levels.LevelFor(slogLogger.name) != core.InfoLevel {
+		t.Errorf("Expected level to be InfoLevel, got %v", slogLogger.levels.LevelFor(slogLogger.name))
 	}
 }
 
@@ -167,8 +170,80 @@ func TestSlogLogger_SetLevel(t *testing.T) {
 	logger.SetLevel(core.ErrorLevel)
 
 	slogLogger := logger.(*SlogLogger)
-	if slogLogger.level != core.ErrorLevel {
-		t.Errorf("Expected level to be ErrorLevel, got %v", slogLogger.level)
+	if slogLogger.levels.LevelFor(slogLogger.name) != core.ErrorLevel {
+		t.Errorf("Expected level to be ErrorLevel, got %v", slogLogger.levels.LevelFor(slogLogger.name))
+	}
+}
+
+func TestSlogLogger_SetLevelFiltersOutput(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.OutputPaths = []string{logFile}
+
+	logger, err := NewSlogLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	child := logger.With("component", "test")
+	logger.SetLevel(core.ErrorLevel)
+	child.Infow("suppressed message")
+	child.Errorw("visible message")
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if strings.Contains(string(data), "suppressed message") {
+		t.Error("Info message should be filtered after SetLevel(ErrorLevel)")
+	}
+	if !strings.Contains(string(data), "visible message") {
+		t.Error("Error message should still be written")
+	}
+}
+
+func TestSlogLogger_NamedLevels(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.OutputPaths = []string{logFile}
+	opt.Levels = map[string]string{"payments": "debug", "db": "error"}
+
+	logger, err := NewSlogLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	payments := logger.Named("payments").Named("refunds")
+	db := logger.Named("db")
+
+	logger.Debugw("root debug")
+	payments.Debugw("payments debug")
+	db.Warnw("db warn")
+	db.Errorw("db error")
+
+	// Runtime changes replace the per-component levels without a rebuild
+	logger.(core.NamedLevelSetter).SetNamedLevels(core.InfoLevel, map[string]core.Level{"db": core.DebugLevel})
+	payments.Debugw("payments debug after reload")
+	db.Debugw("db debug after reload")
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	output := string(data)
+
+	for _, msg := range []string{"payments debug", "db error", "db debug after reload"} {
+		if !strings.Contains(output, msg) {
+			t.Errorf("Expected %q to be written", msg)
+		}
+	}
+	for _, msg := range []string{"root debug", "db warn", "payments debug after reload"} {
+		if strings.Contains(output, msg) {
+			t.Errorf("Expected %q to be filtered", msg)
+		}
+	}
+	if !strings.Contains(output, `"logger":"payments.refunds"`) {
+		t.Errorf("Expected logger name in output, got %s", output)
 	}
 }
 
@@ -294,17 +369,23 @@ func TestSlogLogger_ConvertToSlogAttrs(t *testing.T) {
 	slogLogger := logger.(*SlogLogger)
 
 	// Test attribute conversion
-	attrs := slogLogger.convertToSlogAttrs("key1", "value1", "key2", 42, "key3")
+	attrs, _ := slogLogger.convertToSlogAttrs("key1", "value1", "key2", 42, "key3")
 
-	// Should have 3 attributes (key3 gets nil value for odd number of args)
-	if len(attrs) != 3 {
-		t.Errorf("Expected 3 attributes, got %d", len(attrs))
+	// Should have 4 attributes (key3 is reported under !BADKEY plus a _malformed count)
+	if len(attrs) != 4 {
+		t.Fatalf("Expected 4 attributes, got %d", len(attrs))
+	}
+	if attrs[2].Key != fields.BadKeyField || attrs[2].Value.String() != "key3" {
+		t.Errorf("Expected dangling key under %s, got %v", fields.BadKeyField, attrs[2])
+	}
+	if attrs[3].Key != fields.MalformedField || attrs[3].Value.Int64() != 1 {
+		t.Errorf("Expected %s=1, got %v", fields.MalformedField, attrs[3])
 	}
 
 	// The function should not panic with odd number of arguments
-	attrs2 := slogLogger.convertToSlogAttrs("single_key")
-	if len(attrs2) != 1 {
-		t.Errorf("Expected 1 attribute for single key, got %d", len(attrs2))
+	attrs2, _ := slogLogger.convertToSlogAttrs("single_key")
+	if len(attrs2) != 2 {
+		t.Errorf("Expected 2 attributes for single key, got %d", len(attrs2))
 	}
 }
 
@@ -0,0 +1,150 @@
+package zap
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+)
+
+// toZapFields converts typed fields to zap fields, expanding top-level errors
+// and resolving marshalers, and standardizing top-level keys.
+func (l *ZapLogger) toZapFields(fs []fields.Field) []zap.Field {
+	fs = fields.ResolveMarshalers(fields.ExpandErrors(fs))
+	zapFields := make([]zap.Field, 0, len(fs))
+	for _, f := range fs {
+		if f.Type == fields.SkipType {
+			continue
+		}
+		zapFields = append(zapFields, toZapField(l.getStandardFieldName(f.Key), f))
+	}
+	return zapFields
+}
+
+// toZapField maps a typed field to the equivalent zap field without reflection
+// for every type except AnyType.
+func toZapField(key string, f fields.Field) zap.Field {
+	switch f.Type {
+	case fields.StringType:
+		return zap.String(key, f.String)
+	case fields.Int64Type:
+		return zap.Int64(key, f.Integer)
+	case fields.Uint64Type:
+		return zap.Uint64(key, uint64(f.Integer))
+	case fields.Float64Type:
+		return zap.Float64(key, f.Float64Value())
+	case fields.BoolType:
+		return zap.Bool(key, f.Integer == 1)
+	case fields.DurationType:
+		return zap.Duration(key, time.Duration(f.Integer))
+	case fields.TimeType:
+		return zap.Time(key, f.TimeValue())
+	case fields.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return zap.String(key, err.Error())
+		}
+		return zap.Any(key, f.Interface)
+	case fields.ObjectType:
+		return zap.Object(key, objectMarshaler(f.Fields()))
+	case fields.ArrayType:
+		return zap.Array(key, arrayMarshaler(f.Fields()))
+	case fields.SkipType:
+		return zap.Skip()
+	default:
+		return zap.Any(key, f.Interface)
+	}
+}
+
+// objectMarshaler encodes nested fields as a zap object
+type objectMarshaler []fields.Field
+
+func (fs objectMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, f := range fs {
+		if f.Type != fields.SkipType {
+			toZapField(f.Key, f).AddTo(enc)
+		}
+	}
+	return nil
+}
+
+// arrayMarshaler encodes element fields as a zap array
+type arrayMarshaler []fields.Field
+
+func (fs arrayMarshaler) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, f := range fs {
+		switch f.Type {
+		case fields.SkipType:
+		case fields.StringType:
+			enc.AppendString(f.String)
+		case fields.Int64Type:
+			enc.AppendInt64(f.Integer)
+		case fields.Uint64Type:
+			enc.AppendUint64(uint64(f.Integer))
+		case fields.Float64Type:
+			enc.AppendFloat64(f.Float64Value())
+		case fields.BoolType:
+			enc.AppendBool(f.Integer == 1)
+		case fields.DurationType:
+			enc.AppendDuration(time.Duration(f.Integer))
+		case fields.TimeType:
+			enc.AppendTime(f.TimeValue())
+		case fields.ErrorType:
+			if err, ok := f.Interface.(error); ok {
+				enc.AppendString(err.Error())
+			}
+		case fields.ObjectType:
+			if err := enc.AppendObject(objectMarshaler(f.Fields())); err != nil {
+				return err
+			}
+		case fields.ArrayType:
+			if err := enc.AppendArray(arrayMarshaler(f.Fields())); err != nil {
+				return err
+			}
+		default:
+			if err := enc.AppendReflected(f.Interface); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sendFieldsToOTLP sends a typed-field log record to OTLP.
+func (l *ZapLogger) sendFieldsToOTLP(level core.Level, msg string, fs []fields.Field) {
+	if l.otlpProvider == nil {
+		return
+	}
+	fs = fields.ResolveMarshalers(fields.ExpandErrors(fs))
+
+	keys := make([]string, len(fs))
+	for i, f := range fs {
+		if f.Type != fields.SkipType {
+			keys[i] = l.getStandardFieldName(f.Key)
+		}
+	}
+	// Grouped attributes are nested, where the reserved keys do not apply
+	if len(l.groups) == 0 {
+		keys = l.keys.Resolve(keys)
+	} else {
+		keys = l.keys.ResolveNested(keys)
+	}
+
+	attributes := make(map[string]interface{}, len(fs))
+	for i, f := range fs {
+		if keys[i] != "" {
+			attributes[keys[i]] = f.Value()
+		}
+	}
+
+	attributes = l.otlpProvider.GroupAttributes(l.groupNames(), attributes)
+
+	// Send log record to OTLP
+	if err := l.otlpProvider.SendLogRecord(level, msg, attributes); err != nil {
+		// Log the error to stderr without causing recursion
+		fmt.Printf("OTLP export error: %v\n", err)
+	}
+}
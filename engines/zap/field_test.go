@@ -0,0 +1,90 @@
+package zap
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+func TestZapLogger_TypedFields(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.OutputPaths = []string{logFile}
+
+	logger, err := NewZapLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	logger.DebugF("filtered", fields.String("user", "alice"))
+	logger.With("service", "billing").InfoF("typed",
+		fields.String("user", "alice"),
+		fields.Int64("count", 42),
+		fields.Float64("ratio", 0.5),
+		fields.Bool("ok", true),
+		fields.Duration("elapsed", 1500*time.Millisecond),
+		fields.Time("at", time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)),
+		fields.Err(errors.New("boom")),
+		fields.Err(nil),
+		fields.String("traceId", "abc"),
+		fields.Object("order", fields.String("id", "o-1"), fields.Int("items", 3)),
+		fields.Array("tags", fields.String("", "a"), fields.Int("", 2)),
+		fields.Any("meta", map[string]int{"retries": 1}),
+	)
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 line, got %d: %s", len(lines), data)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"message":  "typed",
+		"service":  "billing",
+		"user":     "alice",
+		"count":    float64(42),
+		"ratio":    0.5,
+		"ok":       true,
+		"error":    "boom",
+		"trace_id": "abc",
+		"at":       "2026-10-18T12:00:00Z",
+	}
+	for key, want := range expected {
+		if entry[key] != want {
+			t.Errorf("Field %s = %v, want %v", key, entry[key], want)
+		}
+	}
+	if _, ok := entry["elapsed"]; !ok {
+		t.Error("Expected elapsed field")
+	}
+	order, _ := entry["order"].(map[string]interface{})
+	if order["id"] != "o-1" || order["items"] != float64(3) {
+		t.Errorf("Unexpected order object %v", entry["order"])
+	}
+	tags, _ := entry["tags"].([]interface{})
+	if len(tags) != 2 || tags[0] != "a" || tags[1] != float64(2) {
+		t.Errorf("Unexpected tags array %v", entry["tags"])
+	}
+	meta, _ := entry["meta"].(map[string]interface{})
+	if meta["retries"] != float64(1) {
+		t.Errorf("Unexpected meta %v", entry["meta"])
+	}
+	if caller, _ := entry["caller"].(string); !strings.Contains(caller, "field_test.go") {
+		t.Errorf("Expected caller in field_test.go, got %v", entry["caller"])
+	}
+}
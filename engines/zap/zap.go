@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"slices"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -16,13 +18,36 @@ import (
 )
 
 // ZapLogger implements the core.Logger interface using Uber's Zap library.
+// Fields added by With are encoded once into contextual and also kept in
+// context, so that they can be written again, deduplicated against the
+// fields of an entry, when the entry repeats one of their keys. contextual
+// also skips the frame of write.
 type ZapLogger struct {
 	logger       *zap.Logger
+	contextual   *zap.Logger
 	sugar        *zap.SugaredLogger
-	level        core.Level
+	context      []zap.Field
+	keys         *fields.KeyResolver
+	levels       *core.LevelRegistry
+	name         string
 	mapper       *fields.FieldMapper
 	callerSkip   int
 	otlpProvider *otlp.LoggerProvider
+	groups       []fieldGroup
+	flatGroups   bool
+	limits       *fields.Limits
+	usage        fields.ContextUsage
+}
+
+// fieldGroup is an open group together with the fields added inside it by With.
+// Groups are applied when an entry is written rather than with zap.Namespace
+// so that caller and stacktrace stay at the top level and a group without
+// fields is omitted, matching the slog engine. The console format writes the
+// fields of a group under dotted keys such as request.method, like the slog
+// text handler, instead of a nested object.
+type fieldGroup struct {
+	name   string
+	fields []zap.Field
 }
 
 // NewZapLogger creates a new Zap-based logger with the provided configuration.
@@ -47,30 +72,68 @@ func NewZapLogger(opt *option.LogOption) (core.Logger, error) {
 		otlpProvider = provider
 	}
 
-	// Create Zap config
-	config := createZapConfig(opt, level)
+	// Per-component levels are resolved by name at runtime
+	namedLevels, err := opt.ParseLevels()
+	if err != nil {
+		return nil, err
+	}
+	levels := core.NewLevelRegistry(level, namedLevels)
+
+	// Field names come from the configured naming scheme
+	mapper, err := opt.FieldMapper()
+	if err != nil {
+		return nil, err
+	}
+	encoder, err := opt.EncoderConfig()
+	if err != nil {
+		return nil, err
+	}
+	limits, err := opt.RecordLimits()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := opt.KeyResolver()
+	if err != nil {
+		return nil, err
+	}
+	if otlpProvider != nil {
+		otlpProvider.SetLimits(limits)
+		otlpProvider.SetFieldMapper(mapper)
+	}
+
+	// Create Zap config. The core accepts every level and namedLevelCore
+	// applies the effective level for each logger name.
+	config := createZapConfig(opt, level, mapper, encoder)
+	config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
 
-	// Create Zap logger
+	// Create Zap logger; filtering is delegated to the level registry
 	zapLogger, err := config.Build(
 		zap.AddCallerSkip(1), // Base skip for our wrapper methods
+		zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+			return &namedLevelCore{Core: c, levels: levels, limits: limits, mapper: mapper}
+		}),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create standardized field mapper wrapper
-	standardizedLogger := newStandardizedZapLogger(zapLogger, fields.NewFieldMapper())
+	standardizedLogger := newStandardizedZapLogger(zapLogger, mapper)
 
 	// Add engine identifier as a persistent field
 	standardizedLogger = standardizedLogger.With(zap.String("engine", "zap"))
 
 	return &ZapLogger{
 		logger:       standardizedLogger,
+		contextual:   standardizedLogger.WithOptions(zap.AddCallerSkip(1)),
 		sugar:        standardizedLogger.Sugar(),
-		level:        level,
-		mapper:       fields.NewFieldMapper(),
+		keys:         keys,
+		levels:       levels,
+		mapper:       mapper,
 		callerSkip:   0,
 		otlpProvider: otlpProvider,
+		flatGroups:   config.Encoding == "console",
+		limits:       limits,
 	}, nil
 }
 
@@ -136,51 +199,139 @@ func (l *ZapLogger) Fatalf(template string, args ...interface{}) {
 
 // Debugw logs a debug message with structured fields.
 func (l *ZapLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if !l.shouldLog(zapcore.DebugLevel) {
+		return
+	}
 	logger := l.withDynamicCallerSkip().(*ZapLogger)
-	logger.sugar.Debugw(msg, logger.standardizeFields(keysAndValues...)...)
-	l.sendToOTLP(core.DebugLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	logger.write(zapcore.DebugLevel, msg, fs)
+	l.sendFieldsToOTLP(core.DebugLevel, msg, fs)
 }
 
 // Infow logs an info message with structured fields.
 func (l *ZapLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if !l.shouldLog(zapcore.InfoLevel) {
+		return
+	}
 	logger := l.withDynamicCallerSkip().(*ZapLogger)
-	logger.sugar.Infow(msg, logger.standardizeFields(keysAndValues...)...)
-	l.sendToOTLP(core.InfoLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	logger.write(zapcore.InfoLevel, msg, fs)
+	l.sendFieldsToOTLP(core.InfoLevel, msg, fs)
 }
 
 // Warnw logs a warning message with structured fields.
 func (l *ZapLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if !l.shouldLog(zapcore.WarnLevel) {
+		return
+	}
 	logger := l.withDynamicCallerSkip().(*ZapLogger)
-	logger.sugar.Warnw(msg, logger.standardizeFields(keysAndValues...)...)
-	l.sendToOTLP(core.WarnLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	logger.write(zapcore.WarnLevel, msg, fs)
+	l.sendFieldsToOTLP(core.WarnLevel, msg, fs)
 }
 
 // Errorw logs an error message with structured fields.
 func (l *ZapLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if !l.shouldLog(zapcore.ErrorLevel) {
+		return
+	}
 	logger := l.withDynamicCallerSkip().(*ZapLogger)
-	logger.sugar.Errorw(msg, logger.standardizeFields(keysAndValues...)...)
-	l.sendToOTLP(core.ErrorLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	logger.write(zapcore.ErrorLevel, msg, fs)
+	l.sendFieldsToOTLP(core.ErrorLevel, msg, fs)
 }
 
 // Fatalw logs a fatal message with structured fields and exits.
 func (l *ZapLogger) Fatalw(msg string, keysAndValues ...interface{}) {
 	logger := l.withDynamicCallerSkip().(*ZapLogger)
-	logger.sugar.Fatalw(msg, logger.standardizeFields(keysAndValues...)...)
-	l.sendToOTLP(core.FatalLevel, msg, keysAndValues...)
+	msg, fs := l.limits.ApplyEntry(l.usage, msg, fields.Normalize(keysAndValues...))
+	// Export before writing because writing a fatal entry exits the process
+	l.sendFieldsToOTLP(core.FatalLevel, msg, fs)
+	logger.write(zapcore.FatalLevel, msg, fs)
+}
+
+// DebugF logs a debug message with typed fields.
+func (l *ZapLogger) DebugF(msg string, fs ...fields.Field) {
+	if !l.shouldLog(zapcore.DebugLevel) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	l.write(zapcore.DebugLevel, msg, fs)
+	l.sendFieldsToOTLP(core.DebugLevel, msg, fs)
+}
+
+// InfoF logs an info message with typed fields.
+func (l *ZapLogger) InfoF(msg string, fs ...fields.Field) {
+	if !l.shouldLog(zapcore.InfoLevel) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	l.write(zapcore.InfoLevel, msg, fs)
+	l.sendFieldsToOTLP(core.InfoLevel, msg, fs)
+}
+
+// WarnF logs a warning message with typed fields.
+func (l *ZapLogger) WarnF(msg string, fs ...fields.Field) {
+	if !l.shouldLog(zapcore.WarnLevel) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	l.write(zapcore.WarnLevel, msg, fs)
+	l.sendFieldsToOTLP(core.WarnLevel, msg, fs)
+}
+
+// ErrorF logs an error message with typed fields.
+func (l *ZapLogger) ErrorF(msg string, fs ...fields.Field) {
+	if !l.shouldLog(zapcore.ErrorLevel) {
+		return
+	}
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	l.write(zapcore.ErrorLevel, msg, fs)
+	l.sendFieldsToOTLP(core.ErrorLevel, msg, fs)
+}
+
+// FatalF logs a fatal message with typed fields and exits.
+func (l *ZapLogger) FatalF(msg string, fs ...fields.Field) {
+	msg, fs = l.limits.ApplyEntry(l.usage, msg, fs)
+	// Export before writing because writing a fatal entry exits the process
+	l.sendFieldsToOTLP(core.FatalLevel, msg, fs)
+	l.write(zapcore.FatalLevel, msg, fs)
 }
 
 // With creates a child logger with the specified key-value pairs.
 func (l *ZapLogger) With(keysAndValues ...interface{}) core.Logger {
-	standardizedFields := l.standardizeFields(keysAndValues...)
-	newSugar := l.sugar.With(standardizedFields...)
-	
+	context := l.context
+	groups := l.groups
+	added, usage := l.standardizeFields(keysAndValues...)
+	if len(groups) == 0 {
+		context = resolveFields(append(append([]zap.Field{}, context...), added...), l.keys.Resolve)
+	} else {
+		// Fields added inside a group are nested when each entry is written
+		groups = append([]fieldGroup{}, groups...)
+		last := &groups[len(groups)-1]
+		last.fields = resolveFields(append(append([]zap.Field{}, last.fields...), added...), l.keys.ResolveNested)
+	}
+
+	contextual := l.contextual
+	if len(groups) == 0 {
+		contextual = l.logger.With(context...).WithOptions(zap.AddCallerSkip(1))
+	}
+
 	return &ZapLogger{
-		logger:       newSugar.Desugar(),
-		sugar:        newSugar,
-		level:        l.level,
+		logger:       l.logger,
+		contextual:   contextual,
+		sugar:        sugarWithContext(l.logger, l.keys, context, groups, l.flatGroups, usage),
+		context:      context,
+		keys:         l.keys,
+		levels:       l.levels,
+		name:         l.name,
 		mapper:       l.mapper,
 		callerSkip:   l.callerSkip,
 		otlpProvider: l.otlpProvider, // Preserve OTLP provider
+		groups:       groups,
+		flatGroups:   l.flatGroups,
+		limits:       l.limits,
+		usage:        usage,
 	}
 }
 
@@ -196,14 +347,89 @@ func (l *ZapLogger) WithCallerSkip(skip int) core.Logger {
 	
 	return &ZapLogger{
 		logger:       newLogger,
-		sugar:        newLogger.Sugar(),
-		level:        l.level,
+		contextual:   l.contextual.WithOptions(zap.AddCallerSkip(skip)),
+		sugar:        sugarWithContext(newLogger, l.keys, l.context, l.groups, l.flatGroups, l.usage),
+		context:      l.context,
+		keys:         l.keys,
+		levels:       l.levels,
+		name:         l.name,
 		mapper:       l.mapper,
 		callerSkip:   l.callerSkip + skip,
 		otlpProvider: l.otlpProvider, // Preserve OTLP provider
+		groups:       l.groups,
+		flatGroups:   l.flatGroups,
+		limits:       l.limits,
+		usage:        l.usage,
 	}
 }
 
+// Named creates a child logger with the given name appended to the parent's.
+func (l *ZapLogger) Named(name string) core.Logger {
+	fullName := joinName(l.name, name)
+	rename := zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		if named, ok := c.(*namedLevelCore); ok {
+			renamed := *named
+			renamed.name = fullName
+			return &renamed
+		}
+		return c
+	})
+	newLogger := l.logger.Named(name).WithOptions(rename)
+
+	return &ZapLogger{
+		logger:       newLogger,
+		contextual:   l.contextual.Named(name).WithOptions(rename),
+		sugar:        sugarWithContext(newLogger, l.keys, l.context, l.groups, l.flatGroups, l.usage),
+		context:      l.context,
+		keys:         l.keys,
+		levels:       l.levels,
+		name:         fullName,
+		mapper:       l.mapper,
+		callerSkip:   l.callerSkip,
+		otlpProvider: l.otlpProvider, // Preserve OTLP provider
+		groups:       l.groups,
+		flatGroups:   l.flatGroups,
+		limits:       l.limits,
+		usage:        l.usage,
+	}
+}
+
+// WithGroup creates a child logger that nests later fields under name.
+func (l *ZapLogger) WithGroup(name string) core.Logger {
+	if name == "" {
+		return l
+	}
+	// Only the outermost group is a top-level key subject to field naming
+	if len(l.groups) == 0 {
+		name = l.getStandardFieldName(name)
+	}
+	groups := append(append([]fieldGroup{}, l.groups...), fieldGroup{name: name})
+
+	return &ZapLogger{
+		logger:       l.logger,
+		contextual:   l.contextual,
+		sugar:        l.sugar,
+		context:      l.context,
+		keys:         l.keys,
+		levels:       l.levels,
+		name:         l.name,
+		mapper:       l.mapper,
+		callerSkip:   l.callerSkip,
+		otlpProvider: l.otlpProvider, // Preserve OTLP provider
+		groups:       groups,
+		flatGroups:   l.flatGroups,
+		limits:       l.limits,
+		usage:        l.usage,
+	}
+}
+
+// shouldLog reports whether an entry at level is written anywhere, so that
+// disabled entries skip normalization, limits and caller detection. OTLP
+// export does not depend on the local level.
+func (l *ZapLogger) shouldLog(level zapcore.Level) bool {
+	return l.otlpProvider != nil || l.logger.Core().Enabled(level)
+}
+
 // withDynamicCallerSkip creates a logger with caller skip based on call stack
 func (l *ZapLogger) withDynamicCallerSkip() core.Logger {
 	// Check if this is a call through global logger function
@@ -236,63 +462,187 @@ func (l *ZapLogger) withDynamicCallerSkip() core.Logger {
 	return l
 }
 
-// SetLevel sets the minimum logging level.
+// SetLevel sets the minimum logging level for this logger's name.
+// The level is shared with every logger of the same name derived from the same root,
+// and on the root logger it becomes the default for all names without their own level.
 func (l *ZapLogger) SetLevel(level core.Level) {
-	l.level = level
-	// Note: Zap doesn't support dynamic level changes easily
-	// This would require creating a new logger with different config
+	l.levels.SetLevel(l.name, level)
+}
+
+// SetNamedLevels replaces the default and per-component levels at runtime.
+func (l *ZapLogger) SetNamedLevels(defaultLevel core.Level, levels map[string]core.Level) {
+	l.levels.Replace(defaultLevel, levels)
+}
+
+// ConfiguredLevel returns the level configured for this logger's name,
+// ignoring levels set with SetLevel.
+func (l *ZapLogger) ConfiguredLevel() core.Level {
+	return l.levels.ConfiguredLevelFor(l.name)
+}
+
+// ResetLevel drops a level set with SetLevel for this logger's name, so the
+// logger follows its configured level again.
+func (l *ZapLogger) ResetLevel() {
+	l.levels.ResetLevel(l.name)
+}
+
+// Enabled reports whether entries at level are written for this logger's name.
+func (l *ZapLogger) Enabled(level core.Level) bool {
+	return level >= l.levels.LevelFor(l.name)
 }
 
 // Helper functions
 
-func (l *ZapLogger) standardizeFields(keysAndValues ...interface{}) []interface{} {
-	standardized := make([]interface{}, 0, len(keysAndValues))
-	
-	for i := 0; i < len(keysAndValues); i += 2 {
-		if i+1 >= len(keysAndValues) {
-			// Odd number of arguments, use empty value for last key
-			key := l.getStandardFieldName(anyToString(keysAndValues[i]))
-			standardized = append(standardized, key, nil)
-			break
+// write writes an entry at level with fs nested under the open groups. The
+// entry is checked against the context encoded by With; when the duplicate
+// key policy drops or renames a context key, it is checked again without the
+// context and written with the resolved context fields instead.
+func (l *ZapLogger) write(level zapcore.Level, msg string, fs []fields.Field) {
+	ce := l.contextual.Check(level, msg)
+	if ce == nil {
+		return
+	}
+	entry, encoded := l.entry(l.toZapFields(fs))
+	if !encoded {
+		if ce = l.logger.WithOptions(zap.AddCallerSkip(1)).Check(level, msg); ce == nil {
+			return
 		}
-		
-		key := anyToString(keysAndValues[i])
-		value := keysAndValues[i+1]
-		
-		// Apply field mapping for consistency
-		standardKey := l.getStandardFieldName(key)
-		standardized = append(standardized, standardKey, value)
 	}
-	
-	return standardized
+	ce.Write(entry...)
 }
 
-func (l *ZapLogger) getStandardFieldName(fieldName string) string {
-	coreMapping := l.mapper.MapCoreFields()
-	if mapped, exists := coreMapping[fieldName]; exists {
-		return mapped
+// entry returns the fields to write for fs nested under the open groups, and
+// whether the context encoded by With can be written as is. Otherwise the
+// returned fields start with the resolved context.
+func (l *ZapLogger) entry(fs []zap.Field) ([]zap.Field, bool) {
+	fs = l.grouped(fs)
+	n := len(l.context)
+	if n+len(fs) == 0 {
+		return fs, true
 	}
-	
-	tracingMapping := l.mapper.MapTracingFields()
-	if mapped, exists := tracingMapping[fieldName]; exists {
-		return mapped
+	keys := make([]string, n+len(fs))
+	for i, f := range l.context {
+		keys[i] = f.Key
 	}
-	
-	return fieldName // Return original if no mapping found
+	for i, f := range fs {
+		keys[n+i] = f.Key
+	}
+	resolved := l.keys.Resolve(keys)
+	switch {
+	case &resolved[0] == &keys[0]:
+		return fs, true
+	case slices.Equal(resolved[:n], keys[:n]):
+		return renameFields(fs, resolved[n:]), true
+	default:
+		return renameFields(append(append([]zap.Field{}, l.context...), fs...), resolved), false
+	}
+}
+
+// grouped nests fs, after the fields added inside each open group, under
+// the open groups. Groups left without fields are omitted.
+func (l *ZapLogger) grouped(fs []zap.Field) []zap.Field {
+	return nestFields(l.groups, fs, l.keys, l.flatGroups)
+}
+
+// nestFields nests fs under groups as objects, or under dotted keys when flat is set.
+func nestFields(groups []fieldGroup, fs []zap.Field, keys *fields.KeyResolver, flat bool) []zap.Field {
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		inner := resolveFields(append(append([]zap.Field{}, g.fields...), fs...), keys.ResolveNested)
+		fs = nil
+		switch {
+		case len(inner) == 0:
+		case flat:
+			for j := range inner {
+				inner[j].Key = g.name + "." + inner[j].Key
+			}
+			fs = inner
+		default:
+			fs = []zap.Field{zap.Object(g.name, groupMarshaler(inner))}
+		}
+	}
+	return fs
+}
+
+// groupNames returns the names of the open groups, outermost first.
+func (l *ZapLogger) groupNames() []string {
+	if len(l.groups) == 0 {
+		return nil
+	}
+	names := make([]string, len(l.groups))
+	for i, g := range l.groups {
+		names[i] = g.name
+	}
+	return names
+}
+
+// groupMarshaler encodes the fields of a group as a zap object
+type groupMarshaler []zap.Field
+
+func (fs groupMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, f := range fs {
+		f.AddTo(enc)
+	}
+	return nil
+}
+
+// resolveFields drops or renames the fields of fs whose keys repeat, using
+// resolve to apply the duplicate key policy.
+func resolveFields(fs []zap.Field, resolve func([]string) []string) []zap.Field {
+	if len(fs) == 0 {
+		return fs
+	}
+	keys := make([]string, len(fs))
+	for i, f := range fs {
+		keys[i] = f.Key
+	}
+	resolved := resolve(keys)
+	if &resolved[0] == &keys[0] {
+		return fs
+	}
+	return renameFields(fs, resolved)
+}
+
+// renameFields writes each field of fs under the matching key of keys,
+// dropping the fields whose key was resolved to "".
+func renameFields(fs []zap.Field, keys []string) []zap.Field {
+	kept := make([]zap.Field, 0, len(fs))
+	for i, f := range fs {
+		if keys[i] == "" && f.Key != "" {
+			continue
+		}
+		f.Key = keys[i]
+		kept = append(kept, f)
+	}
+	return kept
 }
 
-func anyToString(v interface{}) string {
-	if v == nil {
-		return "<nil>"
+// sugarWithContext returns a sugared logger that also writes the context
+// fields, the fields added inside open groups and what limits cut from them,
+// for the methods that take no fields of their own.
+func sugarWithContext(logger *zap.Logger, keys *fields.KeyResolver, context []zap.Field, groups []fieldGroup, flat bool, usage fields.ContextUsage) *zap.SugaredLogger {
+	fs := append(append([]zap.Field{}, context...), nestFields(groups, nil, keys, flat)...)
+	if marker, ok := usage.Marker(); ok {
+		fs = append(fs, toZapField(marker.Key, marker))
 	}
-	if s, ok := v.(string); ok {
-		return s
+	if fs := resolveFields(fs, keys.Resolve); len(fs) > 0 {
+		logger = logger.With(fs...)
 	}
-	// Use fmt.Sprintf for simple string conversion
-	return fmt.Sprintf("%v", v)
+	return logger.Sugar()
 }
 
-func createZapConfig(opt *option.LogOption, level core.Level) zap.Config {
+// standardizeFields normalizes key-value pairs added by With into limited zap
+// fields with standard keys, and returns the usage of the resulting context.
+func (l *ZapLogger) standardizeFields(keysAndValues ...interface{}) ([]zap.Field, fields.ContextUsage) {
+	fs, usage := l.limits.ApplyContext(l.usage, fields.Normalize(keysAndValues...))
+	return l.toZapFields(fs), usage
+}
+
+func (l *ZapLogger) getStandardFieldName(fieldName string) string {
+	return l.mapper.StandardName(fieldName)
+}
+
+func createZapConfig(opt *option.LogOption, level core.Level, mapper *fields.FieldMapper, encoder *fields.EncoderConfig) zap.Config {
 	// Start with appropriate preset
 	var config zap.Config
 	if opt.Development {
@@ -323,25 +673,46 @@ func createZapConfig(opt *option.LogOption, level core.Level) zap.Config {
 	}
 
 	// Configure encoder with standardized field names
-	config.EncoderConfig = createStandardizedEncoderConfig()
+	config.EncoderConfig = createStandardizedEncoderConfig(mapper, encoder)
 
 	return config
 }
 
-func createStandardizedEncoderConfig() zapcore.EncoderConfig {
+func createStandardizedEncoderConfig(mapper *fields.FieldMapper, encoder *fields.EncoderConfig) zapcore.EncoderConfig {
 	config := zap.NewProductionEncoderConfig()
 	
 	// Use our standardized field names
-	config.TimeKey = fields.TimestampField
-	config.LevelKey = fields.LevelField
-	config.MessageKey = fields.MessageField
-	config.CallerKey = fields.CallerField
-	config.StacktraceKey = fields.StacktraceField
+	config.TimeKey = mapper.StandardName(fields.TimestampField)
+	config.LevelKey = mapper.StandardName(fields.LevelField)
+	config.MessageKey = mapper.StandardName(fields.MessageField)
+	config.CallerKey = mapper.StandardName(fields.CallerField)
+	config.NameKey = mapper.StandardName(fields.LoggerField)
+	config.StacktraceKey = mapper.StandardName(fields.StacktraceField)
 	
-	// Configure time format
-	config.EncodeTime = zapcore.RFC3339NanoTimeEncoder
-	config.EncodeLevel = zapcore.LowercaseLevelEncoder
-	config.EncodeCaller = zapcore.ShortCallerEncoder
+	// Encode through the shared encoder configuration so slog output matches
+	config.EncodeTime = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+		if epoch, ok := encoder.EpochTime(t); ok {
+			enc.AppendInt64(epoch)
+			return
+		}
+		enc.AppendString(encoder.FormatTime(t))
+	}
+	config.EncodeLevel = func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+		enc.AppendString(encoder.FormatLevel(level.String()))
+	}
+	config.EncodeCaller = func(caller zapcore.EntryCaller, enc zapcore.PrimitiveArrayEncoder) {
+		enc.AppendString(encoder.FormatCaller(caller.File, caller.Line, caller.Function))
+	}
+	switch encoder.DurationFormat {
+	case fields.MillisDurationFormatter:
+		config.EncodeDuration = zapcore.MillisDurationEncoder
+	case fields.NanosDurationFormatter:
+		config.EncodeDuration = zapcore.NanosDurationEncoder
+	case fields.StringDurationFormatter:
+		config.EncodeDuration = zapcore.StringDurationEncoder
+	default:
+		config.EncodeDuration = zapcore.SecondsDurationEncoder
+	}
 	
 	return config
 }
@@ -378,6 +749,58 @@ func normalizeOutputPaths(paths []string) []string {
 	return normalized
 }
 
+// namedLevelCore filters entries using the level registered for the logger name.
+// It also cuts messages longer than the limits, which covers the methods
+// that format a message without going through fields.Limits.Apply.
+type namedLevelCore struct {
+	zapcore.Core
+	levels *core.LevelRegistry
+	name   string
+	limits *fields.Limits
+	mapper *fields.FieldMapper
+}
+
+func (c *namedLevelCore) Enabled(level zapcore.Level) bool {
+	return level >= mapToZapLevel(c.levels.LevelFor(c.name)) && c.Core.Enabled(level)
+}
+
+func (c *namedLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	with := *c
+	with.Core = c.Core.With(fields)
+	return &with
+}
+
+func (c *namedLevelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checked
+	}
+	// Entries whose message must be cut are written through Write
+	if msg, _ := c.limits.TruncateMessage(entry.Message); len(msg) < len(entry.Message) {
+		return checked.AddCore(entry, c)
+	}
+	return c.Core.Check(entry, checked)
+}
+
+func (c *namedLevelCore) Write(entry zapcore.Entry, fs []zapcore.Field) error {
+	msg, marker := c.limits.TruncateMessage(entry.Message)
+	entry.Message = msg
+	for _, f := range marker {
+		fs = append(fs[:len(fs):len(fs)], toZapField(c.mapper.StandardName(f.Key), f))
+	}
+	return c.Core.Write(entry, fs)
+}
+
+func joinName(parent, name string) string {
+	switch {
+	case parent == "":
+		return name
+	case name == "":
+		return parent
+	default:
+		return parent + "." + name
+	}
+}
+
 // standardizedZapLogger wraps zap.Logger to ensure field standardization
 type standardizedZapLogger struct {
 	*zap.Logger
@@ -391,32 +814,3 @@ func newStandardizedZapLogger(logger *zap.Logger, mapper *fields.FieldMapper) *z
 	_ = mapper // Silence unused warning
 	return logger
 }
-
-// sendToOTLP sends log data to OTLP as a log record.
-func (l *ZapLogger) sendToOTLP(level core.Level, msg string, keysAndValues ...interface{}) {
-	if l.otlpProvider == nil {
-		return
-	}
-
-	// Convert keysAndValues to map
-	attributes := make(map[string]interface{})
-	
-	for i := 0; i < len(keysAndValues); i += 2 {
-		if i+1 >= len(keysAndValues) {
-			break
-		}
-		
-		key := anyToString(keysAndValues[i])
-		value := keysAndValues[i+1]
-		
-		// Apply field mapping
-		standardKey := l.getStandardFieldName(key)
-		attributes[standardKey] = value
-	}
-
-	// Send log record to OTLP
-	if err := l.otlpProvider.SendLogRecord(level, msg, attributes); err != nil {
-		// Log the error to stderr without causing recursion
-		fmt.Printf("OTLP export error: %v\n", err)
-	}
-}
\ No newline at end of file
@@ -2,6 +2,8 @@ package zap
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -27,8 +29,8 @@ func TestNewZapLogger(t *testing.T) {
 		t.Fatal("NewZapLogger() didn't return *ZapLogger")
 	}
 
-	if zapLogger.level != core.InfoLevel {
-		t.Errorf("Expected level to be InfoLevel, got %v", zapLogger.level)
+	if zapLogger.levels.LevelFor(zapLogger.name) != core.InfoLevel {
+		t.Errorf("Expected level to be InfoLevel, got %v", zapLogger.levels.LevelFor(zapLogger.name))
 	}
 }
 
@@ -129,6 +131,40 @@ func TestZapLogger_With(t *testing.T) {
 	t.Log("Child logger created and used successfully")
 }
 
+func TestZapLogger_WithEncodesContextOnce(t *testing.T) {
+	opt := option.DefaultLogOption()
+	logger, err := NewZapLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	child := logger.With("service", "api", "region", "eu").(*ZapLogger)
+
+	// Entries without repeated keys are written with the pre-encoded context
+	user, _ := child.standardizeFields("user", "alice")
+	entry, encoded := child.entry(user)
+	if !encoded {
+		t.Error("Expected an entry without repeated keys to use the pre-encoded context")
+	}
+	if len(entry) != 1 || entry[0].Key != "user" {
+		t.Errorf("Expected only the entry field, got %v", entry)
+	}
+
+	// A repeated context key writes the resolved context with the entry
+	region, _ := child.standardizeFields("region", "us")
+	entry, encoded = child.entry(region)
+	if encoded {
+		t.Error("Expected an entry repeating a context key to bypass the pre-encoded context")
+	}
+	var keys []string
+	for _, f := range entry {
+		keys = append(keys, f.Key)
+	}
+	if strings.Join(keys, ",") != "service,region" || entry[1].String != "us" {
+		t.Errorf("Expected the call-site region to replace the context one, got %v", entry)
+	}
+}
+
 func TestZapLogger_WithCallerSkip(t *testing.T) {
 	opt := option.DefaultLogOption()
 	logger, err := NewZapLogger(opt)
@@ -167,8 +203,80 @@ func TestZapLogger_SetLevel(t *testing.T) {
 	logger.SetLevel(core.ErrorLevel)
 
 	zapLogger := logger.(*ZapLogger)
-	if zapLogger.level != core.ErrorLevel {
-		t.Errorf("Expected level to be ErrorLevel, got %v", zapLogger.level)
+	if zapLogger.levels.LevelFor(zapLogger.name) != core.ErrorLevel {
+		t.Errorf("Expected level to be ErrorLevel, got %v", zapLogger.levels.LevelFor(zapLogger.name))
+	}
+}
+
+func TestZapLogger_SetLevelFiltersOutput(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.OutputPaths = []string{logFile}
+
+	logger, err := NewZapLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	child := logger.With("component", "test")
+	logger.SetLevel(core.ErrorLevel)
+	child.Infow("suppressed message")
+	child.Errorw("visible message")
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if strings.Contains(string(data), "suppressed message") {
+		t.Error("Info message should be filtered after SetLevel(ErrorLevel)")
+	}
+	if !strings.Contains(string(data), "visible message") {
+		t.Error("Error message should still be written")
+	}
+}
+
+func TestZapLogger_NamedLevels(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.OutputPaths = []string{logFile}
+	opt.Levels = map[string]string{"payments": "debug", "db": "error"}
+
+	logger, err := NewZapLogger(opt)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	payments := logger.Named("payments").Named("refunds")
+	db := logger.Named("db")
+
+	logger.Debugw("root debug")
+	payments.Debugw("payments debug")
+	db.Warnw("db warn")
+	db.Errorw("db error")
+
+	// Runtime changes replace the per-component levels without a rebuild
+	logger.(core.NamedLevelSetter).SetNamedLevels(core.InfoLevel, map[string]core.Level{"db": core.DebugLevel})
+	payments.Debugw("payments debug after reload")
+	db.Debugw("db debug after reload")
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	output := string(data)
+
+	for _, msg := range []string{"payments debug", "db error", "db debug after reload"} {
+		if !strings.Contains(output, msg) {
+			t.Errorf("Expected %q to be written", msg)
+		}
+	}
+	for _, msg := range []string{"root debug", "db warn", "payments debug after reload"} {
+		if strings.Contains(output, msg) {
+			t.Errorf("Expected %q to be filtered", msg)
+		}
+	}
+	if !strings.Contains(output, `"logger":"payments.refunds"`) {
+		t.Errorf("Expected logger name in output, got %s", output)
 	}
 }
 
@@ -213,17 +321,17 @@ func TestZapLogger_StandardizeFields(t *testing.T) {
 	zapLogger := logger.(*ZapLogger)
 
 	// Test field standardization
-	standardized := zapLogger.standardizeFields("ts", "2023-01-01", "msg", "test", "custom", "value")
+	standardized, _ := zapLogger.standardizeFields("ts", "2023-01-01", "msg", "test", "custom", "value")
 
-	expected := []interface{}{fields.TimestampField, "2023-01-01", fields.MessageField, "test", "custom", "value"}
+	expected := []string{fields.TimestampField, fields.MessageField, "custom"}
 	
 	if len(standardized) != len(expected) {
 		t.Fatalf("Expected %d fields, got %d", len(expected), len(standardized))
 	}
 
-	for i, v := range expected {
-		if standardized[i] != v {
-			t.Errorf("Field %d: expected %v, got %v", i, v, standardized[i])
+	for i, key := range expected {
+		if standardized[i].Key != key {
+			t.Errorf("Field %d: expected key %v, got %v", i, key, standardized[i].Key)
 		}
 	}
 }
@@ -238,15 +346,18 @@ func TestZapLogger_StandardizeFields_OddArgs(t *testing.T) {
 	zapLogger := logger.(*ZapLogger)
 
 	// Test with odd number of arguments
-	standardized := zapLogger.standardizeFields("key1", "value1", "key2")
+	standardized, _ := zapLogger.standardizeFields("key1", "value1", "key2")
 
-	if len(standardized) != 4 {
-		t.Errorf("Expected 4 fields for odd args, got %d", len(standardized))
+	// key1, the dangling key under !BADKEY and the _malformed count
+	if len(standardized) != 3 {
+		t.Fatalf("Expected 3 fields for odd args, got %d", len(standardized))
 	}
 
-	// Last value should be nil for the unpaired key
-	if standardized[3] != nil {
-		t.Errorf("Expected last value to be nil, got %v", standardized[3])
+	if standardized[1].Key != fields.BadKeyField || standardized[1].String != "key2" {
+		t.Errorf("Expected dangling key under %s, got %+v", fields.BadKeyField, standardized[1])
+	}
+	if standardized[2].Key != fields.MalformedField || standardized[2].Integer != 1 {
+		t.Errorf("Expected %s=1, got %+v", fields.MalformedField, standardized[2])
 	}
 }
 
@@ -302,7 +413,7 @@ func TestCreateZapConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			level, _ := core.ParseLevel(tt.opt.Level)
-			config := createZapConfig(tt.opt, level)
+			config := createZapConfig(tt.opt, level, fields.NewFieldMapper(), fields.DefaultEncoderConfig())
 
 			// Check that config was created successfully
 			if config.Level.Level().String() != strings.ToLower(tt.opt.Level) {
@@ -326,7 +437,7 @@ func TestCreateZapConfig(t *testing.T) {
 }
 
 func TestCreateStandardizedEncoderConfig(t *testing.T) {
-	config := createStandardizedEncoderConfig()
+	config := createStandardizedEncoderConfig(fields.NewFieldMapper(), fields.DefaultEncoderConfig())
 
 	// Check that standardized field names are used
 	if config.TimeKey != fields.TimestampField {
@@ -404,36 +515,6 @@ func TestZapLogger_Different_Formats(t *testing.T) {
 	}
 }
 
-func TestAnyToString(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    interface{}
-		expected string
-	}{
-		{"nil", nil, "<nil>"},
-		{"string", "hello", "hello"},
-		{"empty string", "", ""},
-		{"number", 42, "42"}, // This will be the String field from zap.Any
-		{"boolean", true, "true"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := anyToString(tt.input)
-			if tt.name == "number" || tt.name == "boolean" {
-				// For non-string types, we just verify it doesn't panic and returns something
-				if result == "" {
-					t.Errorf("anyToString(%v) returned empty string", tt.input)
-				}
-			} else {
-				if result != tt.expected {
-					t.Errorf("anyToString(%v) = %v, want %v", tt.input, result, tt.expected)
-				}
-			}
-		})
-	}
-}
-
 func TestZapLogger_WithCtx(t *testing.T) {
 	opt := option.DefaultLogOption()
 	logger, err := NewZapLogger(opt)
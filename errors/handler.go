@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 // ErrorType represents different types of errors that can occur
@@ -57,6 +58,15 @@ func (e *LoggerError) Unwrap() error {
 	return e.Cause
 }
 
+// ErrorFields returns the component and error category so that logging a
+// LoggerError records them as structured fields.
+func (e *LoggerError) ErrorFields() []fields.Field {
+	return []fields.Field{
+		fields.String("component", e.Component),
+		fields.String("category", e.Type.String()),
+	}
+}
+
 // NewError creates a new LoggerError
 func NewError(errType ErrorType, component, message string, cause error) *LoggerError {
 	return &LoggerError{
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 func TestNewError(t *testing.T) {
@@ -322,7 +323,38 @@ func (l *testLogger) Infow(msg string, keysAndValues ...interface{})         {}
 func (l *testLogger) Warnw(msg string, keysAndValues ...interface{})         {}
 func (l *testLogger) Errorw(msg string, keysAndValues ...interface{})        {}
 func (l *testLogger) Fatalw(msg string, keysAndValues ...interface{})        {}
+func (l *testLogger) DebugF(msg string, fs ...fields.Field)          {}
+func (l *testLogger) InfoF(msg string, fs ...fields.Field)           {}
+func (l *testLogger) WarnF(msg string, fs ...fields.Field)           {}
+func (l *testLogger) ErrorF(msg string, fs ...fields.Field)          {}
+func (l *testLogger) FatalF(msg string, fs ...fields.Field)          {}
 func (l *testLogger) With(keysAndValues ...interface{}) core.Logger          { return l }
 func (l *testLogger) WithCtx(ctx context.Context, keysAndValues ...interface{}) core.Logger { return l }
 func (l *testLogger) WithCallerSkip(skip int) core.Logger                    { return l }
-func (l *testLogger) SetLevel(level core.Level)                              {}
\ No newline at end of file
+func (l *testLogger) WithGroup(name string) core.Logger                    { return l }
+func (l *testLogger) Named(name string) core.Logger                          { return l }
+func (l *testLogger) SetLevel(level core.Level)                              {}
+func TestLoggerError_ErrorFields(t *testing.T) {
+	err := NewError(OTLPError, "exporter", "send failed", nil)
+
+	got := fields.ExpandError(fields.ErrorField, fmt.Errorf("flush: %w", err))
+	values := map[string]interface{}{}
+	for _, f := range got {
+		values[f.Key] = f.Value()
+	}
+
+	causes, _ := values[fields.ErrorCausesField].([]interface{})
+	if len(causes) != 1 {
+		t.Fatalf("Expected one cause, got %v", values[fields.ErrorCausesField])
+	}
+	cause := causes[0].(map[string]interface{})
+	if cause["component"] != "exporter" || cause["category"] != "otlp_error" {
+		t.Errorf("Unexpected cause fields: %v", cause)
+	}
+
+	got = fields.ExpandError(fields.ErrorField, err)
+	if got[2].Key != "error_component" || got[2].String != "exporter" ||
+		got[3].Key != "error_category" || got[3].String != "otlp_error" {
+		t.Errorf("Unexpected fields: %+v", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 // NoOpLogger is a logger that does nothing - used as a fallback when all else fails
@@ -59,6 +60,21 @@ func (n *NoOpLogger) Errorw(msg string, keysAndValues ...interface{}) {}
 // Fatalw does nothing (note: this breaks the typical Fatal behavior of exiting)
 func (n *NoOpLogger) Fatalw(msg string, keysAndValues ...interface{}) {}
 
+// DebugF does nothing
+func (n *NoOpLogger) DebugF(msg string, fs ...fields.Field) {}
+
+// InfoF does nothing
+func (n *NoOpLogger) InfoF(msg string, fs ...fields.Field) {}
+
+// WarnF does nothing
+func (n *NoOpLogger) WarnF(msg string, fs ...fields.Field) {}
+
+// ErrorF does nothing
+func (n *NoOpLogger) ErrorF(msg string, fs ...fields.Field) {}
+
+// FatalF does nothing (note: this breaks the typical Fatal behavior of exiting)
+func (n *NoOpLogger) FatalF(msg string, fs ...fields.Field) {}
+
 // With returns the same NoOp logger
 func (n *NoOpLogger) With(keysAndValues ...interface{}) core.Logger {
 	return n
@@ -74,5 +90,15 @@ func (n *NoOpLogger) WithCallerSkip(skip int) core.Logger {
 	return n
 }
 
+// WithGroup returns the same NoOp logger
+func (n *NoOpLogger) WithGroup(name string) core.Logger {
+	return n
+}
+
+// Named returns the same NoOp logger
+func (n *NoOpLogger) Named(name string) core.Logger {
+	return n
+}
+
 // SetLevel does nothing
 func (n *NoOpLogger) SetLevel(level core.Level) {}
\ No newline at end of file
@@ -4,10 +4,14 @@ go 1.25.0
 
 require (
 	github.com/kart-io/logger v0.0.0
+	github.com/kart-io/logger/integrations/echo v0.0.0
 	github.com/labstack/echo/v4 v4.13.4
 )
 
-replace github.com/kart-io/logger => ../..
+replace (
+	github.com/kart-io/logger => ../..
+	github.com/kart-io/logger/integrations/echo => ../../integrations/echo
+)
 
 require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.20.0 // indirect
@@ -14,6 +14,7 @@ import (
 
 	"github.com/kart-io/logger"
 	"github.com/kart-io/logger/core"
+	echologger "github.com/kart-io/logger/integrations/echo"
 	"github.com/kart-io/logger/option"
 )
 
@@ -42,8 +43,8 @@ func main() {
 	e.HideBanner = true // Hide Echo banner for cleaner output
 
 	// Add our unified logger middleware
-	e.Use(EchoLoggerMiddleware(coreLogger))
-	e.Use(EchoRecoveryMiddleware(coreLogger))
+	echoAdapter := echologger.NewEchoAdapter(coreLogger)
+	e.Use(echoAdapter.Middleware(), echoAdapter.Recovery())
 	
 	// Add CORS middleware for API access
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -95,117 +96,6 @@ func main() {
 	}
 }
 
-// EchoLoggerMiddleware creates an Echo middleware that logs HTTP requests using our unified logger
-func EchoLoggerMiddleware(logger core.Logger) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			startTime := time.Now()
-
-			// Process request
-			err := next(c)
-
-			// Calculate processing time
-			latency := time.Since(startTime)
-
-			// Get request info
-			req := c.Request()
-			res := c.Response()
-			method := req.Method
-			path := c.Path()
-			if path == "" {
-				path = req.URL.Path
-			}
-			statusCode := res.Status
-			clientIP := c.RealIP()
-			userAgent := req.UserAgent()
-			
-			// Build log fields
-			logFields := []interface{}{
-				"component", "echo",
-				"method", method,
-				"path", path,
-				"route", c.Path(), // Echo route pattern
-				"status_code", statusCode,
-				"latency_ms", float64(latency.Nanoseconds()) / 1e6,
-				"client_ip", clientIP,
-				"user_agent", userAgent,
-				"bytes_in", req.ContentLength,
-				"bytes_out", res.Size,
-			}
-
-			// Add request ID if present
-			if requestID := req.Header.Get("X-Request-ID"); requestID != "" {
-				logFields = append(logFields, "request_id", requestID)
-			}
-
-			// Add trace info if present
-			if traceID := req.Header.Get("X-Trace-ID"); traceID != "" {
-				logFields = append(logFields, "trace_id", traceID)
-			}
-
-			// Add user info if authenticated
-			if userID := c.Get("user_id"); userID != nil {
-				logFields = append(logFields, "user_id", userID)
-			}
-
-			// Add error info if present
-			if err != nil {
-				if echoErr, ok := err.(*echo.HTTPError); ok {
-					logFields = append(logFields, "error_code", echoErr.Code, "error_message", echoErr.Message)
-				} else {
-					logFields = append(logFields, "error", err.Error())
-				}
-			}
-
-			// Log based on status code and errors
-			message := method + " " + path
-			switch {
-			case err != nil:
-				logger.Errorw(message, logFields...)
-			case statusCode >= 400 && statusCode < 500:
-				logger.Warnw(message, logFields...)
-			case statusCode >= 500:
-				logger.Errorw(message, logFields...)
-			case latency > 5*time.Second:
-				logger.Warnw(message, append(logFields, "slow_request", true)...)
-			default:
-				logger.Infow(message, logFields...)
-			}
-
-			return err
-		}
-	}
-}
-
-// EchoRecoveryMiddleware creates an Echo middleware that handles panics using our unified logger
-func EchoRecoveryMiddleware(logger core.Logger) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			defer func() {
-				if r := recover(); r != nil {
-					logger.Errorw("Panic recovered",
-						"component", "echo",
-						"method", c.Request().Method,
-						"path", c.Request().URL.Path,
-						"route", c.Path(),
-						"client_ip", c.RealIP(),
-						"error", r,
-						"panic", true,
-					)
-					
-					// Return JSON error response
-					c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": "Internal server error",
-						"code":  "PANIC_RECOVERED",
-						"timestamp": time.Now().Format(time.RFC3339),
-					})
-				}
-			}()
-			return next(c)
-		}
-	}
-}
-
 func setupEchoRoutes(e *echo.Echo, logger core.Logger) {
 	// Root endpoint
 	e.GET("/", func(c echo.Context) error {
@@ -5,9 +5,13 @@ go 1.25.0
 require (
 	github.com/gin-gonic/gin v1.10.1
 	github.com/kart-io/logger v0.0.0
+	github.com/kart-io/logger/integrations/gin v0.0.0
 )
 
-replace github.com/kart-io/logger => ../..
+replace (
+	github.com/kart-io/logger => ../..
+	github.com/kart-io/logger/integrations/gin => ../../integrations/gin
+)
 
 require (
 	github.com/bytedance/sonic v1.11.6 // indirect
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kart-io/logger"
 	"github.com/kart-io/logger/core"
+	ginlogger "github.com/kart-io/logger/integrations/gin"
 	"github.com/kart-io/logger/option"
 )
 
@@ -41,8 +42,10 @@ func main() {
 	r := gin.New()
 
 	// Add our unified logger middleware
-	r.Use(GinLoggerMiddleware(coreLogger))
-	r.Use(GinRecoveryMiddleware(coreLogger))
+	ginConfig := ginlogger.DefaultConfig()
+	ginConfig.SkipPaths = []string{"/health"}
+	ginAdapter := ginlogger.NewGinAdapterWithConfig(coreLogger, ginConfig)
+	r.Use(ginAdapter.Middleware(), ginAdapter.Recovery())
 
 	// 3. Setup routes with different logging scenarios
 	setupRoutes(r, coreLogger)
@@ -90,84 +93,6 @@ func main() {
 	}
 }
 
-// GinLoggerMiddleware creates a Gin middleware that logs HTTP requests using our unified logger
-func GinLoggerMiddleware(logger core.Logger) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		startTime := time.Now()
-
-		// Process request
-		c.Next()
-
-		// Calculate processing time
-		latency := time.Since(startTime)
-		
-		// Get request info
-		method := c.Request.Method
-		path := c.Request.URL.Path
-		statusCode := c.Writer.Status()
-		clientIP := c.ClientIP()
-		userAgent := c.Request.UserAgent()
-		
-		// Determine log level based on status code
-		logFields := []interface{}{
-			"component", "gin",
-			"method", method,
-			"path", path,
-			"status_code", statusCode,
-			"latency_ms", float64(latency.Nanoseconds()) / 1e6,
-			"client_ip", clientIP,
-			"user_agent", userAgent,
-		}
-
-		// Add request ID if present
-		if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
-			logFields = append(logFields, "request_id", requestID)
-		}
-
-		// Add user info if authenticated
-		if userID, exists := c.Get("user_id"); exists {
-			logFields = append(logFields, "user_id", userID)
-		}
-
-		// Log based on status code
-		message := method + " " + path
-		switch {
-		case statusCode >= 400 && statusCode < 500:
-			logger.Warnw(message, logFields...)
-		case statusCode >= 500:
-			logger.Errorw(message, logFields...)
-		case latency > 5*time.Second:
-			logger.Warnw(message, append(logFields, "slow_request", true)...)
-		default:
-			logger.Infow(message, logFields...)
-		}
-	}
-}
-
-// GinRecoveryMiddleware creates a Gin middleware that handles panics using our unified logger
-func GinRecoveryMiddleware(logger core.Logger) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		defer func() {
-			if err := recover(); err != nil {
-				logger.Errorw("Panic recovered",
-					"component", "gin",
-					"method", c.Request.Method,
-					"path", c.Request.URL.Path,
-					"client_ip", c.ClientIP(),
-					"error", err,
-					"panic", true,
-				)
-				
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error": "Internal server error",
-					"code":  "PANIC_RECOVERED",
-				})
-			}
-		}()
-		c.Next()
-	}
-}
-
 func setupRoutes(r *gin.Engine, logger core.Logger) {
 	// Root endpoint
 	r.GET("/", func(c *gin.Context) {
@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/fields"
 	"github.com/kart-io/logger/option"
 )
 
 func main() {
-	fmt.Println("=== Logger Performance Comparison ===\n")
+	fmt.Println("=== Logger Performance Comparison ===")
+	fmt.Println()
 
 	// Single-threaded performance
 	demonstrateSingleThreadedPerformance()
@@ -21,6 +23,9 @@ func main() {
 	// Memory allocation comparison
 	demonstrateMemoryUsage()
 
+	// Typed fields vs key-value pairs
+	demonstrateTypedFields()
+
 	fmt.Println("\n=== Performance Comparison Complete ===")
 }
 
@@ -308,4 +313,55 @@ func demonstrateMemoryUsage() {
 	fmt.Println("- Child loggers (With) reuse field allocations efficiently")
 
 	fmt.Println()
-}
\ No newline at end of file
+}
+
+// demonstrateTypedFields compares key-value pairs with typed fields
+func demonstrateTypedFields() {
+	fmt.Println("4. Typed Fields Performance Comparison")
+	fmt.Println("======================================")
+
+	iterations := 10000
+
+	for _, engine := range []string{"slog", "zap"} {
+		opt := &option.LogOption{
+			Engine:      engine,
+			Level:       "INFO",
+			Format:      "json",
+			OutputPaths: []string{"/dev/null"},
+			OTLP:        &option.OTLPOption{},
+		}
+
+		engineLogger, err := logger.New(opt)
+		if err != nil {
+			panic(err)
+		}
+
+		start := time.Now()
+		for i := 0; i < iterations; i++ {
+			engineLogger.Infow("Performance test message",
+				"iteration", i,
+				"worker_id", "worker_001",
+				"batch_size", 100,
+				"elapsed", 250*time.Millisecond,
+			)
+		}
+		kvDuration := time.Since(start)
+
+		start = time.Now()
+		for i := 0; i < iterations; i++ {
+			engineLogger.InfoF("Performance test message",
+				fields.Int("iteration", i),
+				fields.String("worker_id", "worker_001"),
+				fields.Int("batch_size", 100),
+				fields.Duration("elapsed", 250*time.Millisecond),
+			)
+		}
+		typedDuration := time.Since(start)
+
+		fmt.Printf("%s Infow: %d logs in %v, InfoF: %d logs in %v\n",
+			engine, iterations, kvDuration, iterations, typedDuration)
+	}
+
+	fmt.Println("\nRun 'go test -bench . -benchmem' to compare allocations per log call")
+	fmt.Println()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+func newBenchLogger(b *testing.B, engine string) core.Logger {
+	b.Helper()
+	// Development mode turns off zap's production sampler, which would
+	// otherwise drop most of these identical entries before their fields
+	// are converted. slog ignores it.
+	l, err := logger.New(&option.LogOption{
+		Engine:      engine,
+		Level:       "INFO",
+		Development: true,
+		Format:      "json",
+		OutputPaths: []string{"/dev/null"},
+		OTLP:        &option.OTLPOption{},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return l
+}
+
+func benchmarkInfow(b *testing.B, engine string) {
+	l := newBenchLogger(b, engine)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Infow("Performance test message",
+			"iteration", i,
+			"worker_id", "worker_001",
+			"batch_size", 100,
+			"ratio", 0.75,
+			"cached", true,
+			"elapsed", 250*time.Millisecond,
+		)
+	}
+}
+
+func benchmarkInfoF(b *testing.B, engine string) {
+	l := newBenchLogger(b, engine)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.InfoF("Performance test message",
+			fields.Int("iteration", i),
+			fields.String("worker_id", "worker_001"),
+			fields.Int("batch_size", 100),
+			fields.Float64("ratio", 0.75),
+			fields.Bool("cached", true),
+			fields.Duration("elapsed", 250*time.Millisecond),
+		)
+	}
+}
+
+func BenchmarkZap_Infow(b *testing.B)  { benchmarkInfow(b, "zap") }
+func BenchmarkZap_InfoF(b *testing.B)  { benchmarkInfoF(b, "zap") }
+func BenchmarkSlog_Infow(b *testing.B) { benchmarkInfow(b, "slog") }
+func BenchmarkSlog_InfoF(b *testing.B) { benchmarkInfoF(b, "slog") }
@@ -0,0 +1,152 @@
+package fields
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DuplicateKeyPolicy decides which field is written when an entry has the
+// same key more than once, typically once from With and once at the call site.
+type DuplicateKeyPolicy string
+
+const (
+	// LastKeyWins writes only the last field with a key, so call-site fields
+	// override context fields. It is the default.
+	LastKeyWins DuplicateKeyPolicy = "last"
+
+	// FirstKeyWins writes only the first field with a key, so context fields
+	// cannot be overridden at the call site.
+	FirstKeyWins DuplicateKeyPolicy = "first"
+
+	// SuffixDuplicateKeys keeps every field and renames repeats to key_2,
+	// key_3 and so on, skipping names already in use.
+	SuffixDuplicateKeys DuplicateKeyPolicy = "suffix"
+)
+
+// ParseDuplicateKeyPolicy parses a policy name case-insensitively. An empty
+// name is LastKeyWins.
+func ParseDuplicateKeyPolicy(text string) (DuplicateKeyPolicy, error) {
+	switch policy := DuplicateKeyPolicy(strings.ToLower(strings.TrimSpace(text))); policy {
+	case "":
+		return LastKeyWins, nil
+	case LastKeyWins, FirstKeyWins, SuffixDuplicateKeys:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid duplicate key policy %q", text)
+	}
+}
+
+// KeyResolver applies a DuplicateKeyPolicy to the keys of an entry. Fields
+// using a reserved key, such as the level or message key written by the
+// logger itself, are always renamed with a suffix so that they neither
+// replace nor repeat it. A nil *KeyResolver leaves keys unchanged.
+type KeyResolver struct {
+	policy   DuplicateKeyPolicy
+	reserved map[string]bool
+}
+
+// NewKeyResolver returns a resolver for policy that protects reserved keys.
+func NewKeyResolver(policy DuplicateKeyPolicy, reserved ...string) *KeyResolver {
+	if policy == "" {
+		policy = LastKeyWins
+	}
+	r := &KeyResolver{policy: policy, reserved: make(map[string]bool, len(reserved))}
+	for _, key := range reserved {
+		if key != "" {
+			r.reserved[key] = true
+		}
+	}
+	return r
+}
+
+// Policy returns the policy applied by r.
+func (r *KeyResolver) Policy() DuplicateKeyPolicy {
+	if r == nil {
+		return LastKeyWins
+	}
+	return r.policy
+}
+
+// Resolve returns the key each of keys is written under, or "" for keys that
+// are dropped. keys itself is returned when nothing changes. Empty keys are
+// left alone. BadKeyField records each malformed argument, so repeats of it
+// are always suffixed and no malformed argument is lost, whatever the policy.
+func (r *KeyResolver) Resolve(keys []string) []string {
+	if r == nil {
+		return keys
+	}
+	return r.resolve(keys, r.reserved)
+}
+
+// ResolveNested is Resolve for the keys inside a group, where the reserved
+// keys of the entry do not apply.
+func (r *KeyResolver) ResolveNested(keys []string) []string {
+	if r == nil {
+		return keys
+	}
+	return r.resolve(keys, nil)
+}
+
+func (r *KeyResolver) resolve(keys []string, reserved map[string]bool) []string {
+	if !hasDuplicates(keys, reserved) {
+		return keys
+	}
+
+	used := make(map[string]bool, len(keys)+len(reserved))
+	for key := range reserved {
+		used[key] = true
+	}
+	for _, key := range keys {
+		used[key] = true
+	}
+	suffixed := func(key string) string {
+		for n := 2; ; n++ {
+			if name := key + "_" + strconv.Itoa(n); !used[name] {
+				used[name] = true
+				return name
+			}
+		}
+	}
+
+	resolved := make([]string, len(keys))
+	seen := make(map[string]int, len(keys))
+	for i, key := range keys {
+		j, repeated := seen[key]
+		switch {
+		case key == "":
+		case reserved[key]:
+			key = suffixed(key)
+		case !repeated:
+			seen[key] = i
+		case key == BadKeyField || r.policy == SuffixDuplicateKeys:
+			key = suffixed(key)
+		case r.policy == FirstKeyWins:
+			key = ""
+		default:
+			resolved[j] = ""
+			seen[key] = i
+		}
+		resolved[i] = key
+	}
+	return resolved
+}
+
+// hasDuplicates reports whether a key other than "" repeats or is reserved.
+// Entries have few fields, so comparing pairs is cheaper than a map.
+func hasDuplicates(keys []string, reserved map[string]bool) bool {
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		if reserved[key] {
+			return true
+		}
+		for _, other := range keys[:i] {
+			if other == key {
+				return true
+			}
+		}
+	}
+	return false
+}
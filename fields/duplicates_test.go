@@ -0,0 +1,117 @@
+package fields
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeyResolver_Resolve(t *testing.T) {
+	reserved := []string{"level", "message", "engine"}
+
+	tests := []struct {
+		name   string
+		policy DuplicateKeyPolicy
+		keys   []string
+		want   []string
+	}{
+		{
+			name:   "no duplicates",
+			policy: LastKeyWins,
+			keys:   []string{"user_id", "request_id"},
+			want:   []string{"user_id", "request_id"},
+		},
+		{
+			name:   "last wins",
+			policy: LastKeyWins,
+			keys:   []string{"user_id", "service", "user_id"},
+			want:   []string{"", "service", "user_id"},
+		},
+		{
+			name:   "first wins",
+			policy: FirstKeyWins,
+			keys:   []string{"user_id", "service", "user_id"},
+			want:   []string{"user_id", "service", ""},
+		},
+		{
+			name:   "suffix",
+			policy: SuffixDuplicateKeys,
+			keys:   []string{"user_id", "user_id", "user_id"},
+			want:   []string{"user_id", "user_id_2", "user_id_3"},
+		},
+		{
+			name:   "suffix skips names in use",
+			policy: SuffixDuplicateKeys,
+			keys:   []string{"id", "id_2", "id"},
+			want:   []string{"id", "id_2", "id_3"},
+		},
+		{
+			name:   "reserved keys are suffixed under every policy",
+			policy: FirstKeyWins,
+			keys:   []string{"level", "message", "engine", "level"},
+			want:   []string{"level_2", "message_2", "engine_2", "level_3"},
+		},
+		{
+			name:   "empty keys are left alone and bad keys are suffixed",
+			policy: FirstKeyWins,
+			keys:   []string{"", BadKeyField, "a", "", BadKeyField},
+			want:   []string{"", BadKeyField, "a", "", BadKeyField + "_2"},
+		},
+		{
+			name:   "bad keys are kept under last wins",
+			policy: LastKeyWins,
+			keys:   []string{BadKeyField, "a", BadKeyField},
+			want:   []string{BadKeyField, "a", BadKeyField + "_2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewKeyResolver(tt.policy, reserved...)
+			if got := r.Resolve(tt.keys); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.keys, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyResolver_ResolveNested(t *testing.T) {
+	r := NewKeyResolver(LastKeyWins, "level")
+
+	got := r.ResolveNested([]string{"level", "id", "id"})
+	want := []string{"level", "", "id"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResolveNested() = %q, want %q", got, want)
+	}
+
+	var nilResolver *KeyResolver
+	keys := []string{"id", "id"}
+	if got := nilResolver.Resolve(keys); !reflect.DeepEqual(got, keys) {
+		t.Errorf("nil Resolve() = %q, want %q", got, keys)
+	}
+}
+
+func TestParseDuplicateKeyPolicy(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    DuplicateKeyPolicy
+		wantErr bool
+	}{
+		{text: "", want: LastKeyWins},
+		{text: "last", want: LastKeyWins},
+		{text: "FIRST", want: FirstKeyWins},
+		{text: " suffix ", want: SuffixDuplicateKeys},
+		{text: "merge", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseDuplicateKeyPolicy(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuplicateKeyPolicy(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuplicateKeyPolicy(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,17 +2,31 @@ package fields
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // EncoderConfig defines how fields should be encoded consistently
 // across different logging engines.
 type EncoderConfig struct {
-	TimeLayout     string
+	// TimeLayout is a time.Format layout, EpochMillisTimeLayout or EpochNanosTimeLayout
+	TimeLayout string
+	// UTC converts times to UTC before encoding; otherwise they keep their location
+	UTC            bool
 	LevelFormatter LevelFormatter
 	CallerFormat   CallerFormatter
+	DurationFormat DurationFormatter
 }
 
+// Time layouts that encode times as integers instead of strings.
+const (
+	// EpochMillisTimeLayout encodes times as Unix milliseconds.
+	EpochMillisTimeLayout = "epoch_millis"
+	// EpochNanosTimeLayout encodes times as Unix nanoseconds.
+	EpochNanosTimeLayout = "epoch_nanos"
+)
+
 // LevelFormatter defines how log levels should be formatted.
 type LevelFormatter int
 
@@ -31,6 +45,22 @@ const (
 	ShortCallerFormatter CallerFormatter = iota
 	// FullCallerFormatter formats as "/full/path/file.go:123"
 	FullCallerFormatter
+	// FunctionCallerFormatter formats as the calling function, e.g. "main.handleRequest"
+	FunctionCallerFormatter
+)
+
+// DurationFormatter defines how time.Duration values should be formatted.
+type DurationFormatter int
+
+const (
+	// SecondsDurationFormatter formats durations as floating-point seconds.
+	SecondsDurationFormatter DurationFormatter = iota
+	// MillisDurationFormatter formats durations as floating-point milliseconds.
+	MillisDurationFormatter
+	// NanosDurationFormatter formats durations as integer nanoseconds.
+	NanosDurationFormatter
+	// StringDurationFormatter formats durations with time.Duration.String, e.g. "1.5s".
+	StringDurationFormatter
 )
 
 // DefaultEncoderConfig returns the default encoding configuration.
@@ -39,6 +69,72 @@ func DefaultEncoderConfig() *EncoderConfig {
 		TimeLayout:     time.RFC3339Nano,
 		LevelFormatter: LowercaseLevelFormatter,
 		CallerFormat:   ShortCallerFormatter,
+		DurationFormat: SecondsDurationFormatter,
+	}
+}
+
+// defaultEncoderConfig is used by the Format methods when called on a nil config.
+var defaultEncoderConfig = DefaultEncoderConfig()
+
+// EpochTime returns t as an integer when TimeLayout is an epoch layout.
+func (c *EncoderConfig) EpochTime(t time.Time) (int64, bool) {
+	if c == nil {
+		c = defaultEncoderConfig
+	}
+	switch c.TimeLayout {
+	case EpochMillisTimeLayout:
+		return t.UnixMilli(), true
+	case EpochNanosTimeLayout:
+		return t.UnixNano(), true
+	default:
+		return 0, false
+	}
+}
+
+// FormatTime formats t with TimeLayout; it is used when EpochTime reports false.
+func (c *EncoderConfig) FormatTime(t time.Time) string {
+	if c == nil {
+		c = defaultEncoderConfig
+	}
+	if c.UTC {
+		t = t.UTC()
+	}
+	layout := c.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	return t.Format(layout)
+}
+
+// FormatLevel formats a level name such as "info" or "WARN".
+func (c *EncoderConfig) FormatLevel(level string) string {
+	if c == nil {
+		c = defaultEncoderConfig
+	}
+	if c.LevelFormatter == UppercaseLevelFormatter {
+		return strings.ToUpper(level)
+	}
+	return strings.ToLower(level)
+}
+
+// FormatCaller formats the caller location.
+func (c *EncoderConfig) FormatCaller(file string, line int, function string) string {
+	if c == nil {
+		c = defaultEncoderConfig
+	}
+	switch c.CallerFormat {
+	case FunctionCallerFormatter:
+		return function
+	case FullCallerFormatter:
+		return file + ":" + strconv.Itoa(line)
+	default:
+		// Keep the last two path segments, e.g. "pkg/file.go:123"
+		if idx := strings.LastIndexByte(file, '/'); idx >= 0 {
+			if idx2 := strings.LastIndexByte(file[:idx], '/'); idx2 >= 0 {
+				file = file[idx2+1:]
+			}
+		}
+		return file + ":" + strconv.Itoa(line)
 	}
 }
 
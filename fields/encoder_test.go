@@ -276,4 +276,71 @@ func TestStandardizedOutput_EmptyFields(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestEncoderConfig_FormatTime(t *testing.T) {
+	at := time.Date(2026, 10, 18, 20, 0, 0, 500, time.FixedZone("UTC+8", 8*60*60))
+
+	tests := []struct {
+		name      string
+		config    *EncoderConfig
+		wantText  string
+		wantEpoch int64
+		isEpoch   bool
+	}{
+		{"default", DefaultEncoderConfig(), "2026-10-18T20:00:00.0000005+08:00", 0, false},
+		{"utc", &EncoderConfig{TimeLayout: time.RFC3339, UTC: true}, "2026-10-18T12:00:00Z", 0, false},
+		{"empty layout", &EncoderConfig{}, "2026-10-18T20:00:00.0000005+08:00", 0, false},
+		{"nil config", nil, "2026-10-18T20:00:00.0000005+08:00", 0, false},
+		{"epoch millis", &EncoderConfig{TimeLayout: EpochMillisTimeLayout}, "", at.UnixMilli(), true},
+		{"epoch nanos", &EncoderConfig{TimeLayout: EpochNanosTimeLayout}, "", at.UnixNano(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epoch, ok := tt.config.EpochTime(at)
+			if ok != tt.isEpoch || epoch != tt.wantEpoch {
+				t.Errorf("EpochTime() = %d, %v, want %d, %v", epoch, ok, tt.wantEpoch, tt.isEpoch)
+			}
+			if !tt.isEpoch {
+				if got := tt.config.FormatTime(at); got != tt.wantText {
+					t.Errorf("FormatTime() = %q, want %q", got, tt.wantText)
+				}
+			}
+		})
+	}
+}
+
+func TestEncoderConfig_FormatLevel(t *testing.T) {
+	lower := &EncoderConfig{LevelFormatter: LowercaseLevelFormatter}
+	upper := &EncoderConfig{LevelFormatter: UppercaseLevelFormatter}
+
+	if got := lower.FormatLevel("WARN"); got != "warn" {
+		t.Errorf("lowercase FormatLevel() = %q, want %q", got, "warn")
+	}
+	if got := upper.FormatLevel("info"); got != "INFO" {
+		t.Errorf("uppercase FormatLevel() = %q, want %q", got, "INFO")
+	}
+}
+
+func TestEncoderConfig_FormatCaller(t *testing.T) {
+	file, line, function := "/src/app/handlers/user.go", 42, "app/handlers.(*User).Get"
+
+	tests := []struct {
+		format CallerFormatter
+		want   string
+	}{
+		{ShortCallerFormatter, "handlers/user.go:42"},
+		{FullCallerFormatter, "/src/app/handlers/user.go:42"},
+		{FunctionCallerFormatter, "app/handlers.(*User).Get"},
+	}
+	for _, tt := range tests {
+		config := &EncoderConfig{CallerFormat: tt.format}
+		if got := config.FormatCaller(file, line, function); got != tt.want {
+			t.Errorf("FormatCaller(%d) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	if got := DefaultEncoderConfig().FormatCaller("main.go", 7, "main.main"); got != "main.go:7" {
+		t.Errorf("FormatCaller() without directories = %q, want %q", got, "main.go:7")
+	}
+}
@@ -0,0 +1,240 @@
+package fields
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxErrorUnwraps bounds how many errors ExpandError visits, protecting
+// against very deep or cyclic Unwrap chains.
+const maxErrorUnwraps = 32
+
+// ErrorFielder is implemented by errors that carry structured fields, such
+// as errors.LoggerError. ExpandError adds them to the entry with the error
+// key as prefix.
+type ErrorFielder interface {
+	ErrorFields() []Field
+}
+
+// stackTracer is implemented by errors annotated with WithStack.
+type stackTracer interface {
+	StackTrace() []uintptr
+}
+
+// withStack annotates an error with the stack of the WithStack caller.
+type withStack struct {
+	err   error
+	stack []uintptr
+}
+
+func (w *withStack) Error() string         { return w.err.Error() }
+func (w *withStack) Unwrap() error         { return w.err }
+func (w *withStack) StackTrace() []uintptr { return w.stack }
+
+// WithStack annotates err with the stack of its caller so that logging it
+// includes where it was created. A nil error returns nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	stack := make([]uintptr, n)
+	copy(stack, pcs[:n])
+	return &withStack{err: err, stack: stack}
+}
+
+// ExpandError expands err into the fields written for an error logged under
+// key: the message under key, the concrete type under key+"_type", the
+// Unwrap/Join cause chain under key+"_causes", the innermost stack carried
+// by the chain under key+"_stack" and any ErrorFielder fields under
+// key+"_"+name. With the standard key these are ErrorField, ErrorTypeField,
+// ErrorCausesField and ErrorStackField. A nil error returns nil.
+//
+// Wrappers that only add a stack, such as WithStack, are transparent: their
+// message matches the error they wrap, so the wrapped error's type is used
+// and they do not appear as causes.
+func ExpandError(key string, err error) []Field {
+	if err == nil {
+		return nil
+	}
+
+	x := errorExpander{budget: maxErrorUnwraps}
+	top := x.peel(err)
+
+	fs := make([]Field, 0, 4)
+	fs = append(fs, String(key, err.Error()), String(key+"_type", errorTypeName(top)))
+	if fielder, ok := top.(ErrorFielder); ok {
+		for _, f := range fielder.ErrorFields() {
+			f.Key = key + "_" + f.Key
+			fs = append(fs, f)
+		}
+	}
+	if causes := x.causes(top); len(causes) > 0 {
+		fs = append(fs, Array(key+"_causes", causes...))
+	}
+	if x.stack != nil {
+		fs = append(fs, String(key+"_stack", formatStack(x.stack)))
+	}
+	return fs
+}
+
+// ExpandErrors returns fs with every top-level error field replaced by the
+// fields of ExpandError. Nested errors keep their message only. fs is
+// returned unchanged when it holds no error fields.
+func ExpandErrors(fs []Field) []Field {
+	i := 0
+	for i < len(fs) && fs[i].Type != ErrorType {
+		i++
+	}
+	if i == len(fs) {
+		return fs
+	}
+
+	expanded := make([]Field, i, len(fs)+4)
+	copy(expanded, fs[:i])
+	for _, f := range fs[i:] {
+		if err, ok := f.Interface.(error); ok && f.Type == ErrorType {
+			expanded = append(expanded, ExpandError(f.Key, err)...)
+			continue
+		}
+		expanded = append(expanded, f)
+	}
+	return expanded
+}
+
+// errorExpander walks an error tree, remembering the last stack it found.
+type errorExpander struct {
+	budget int
+	stack  []uintptr
+}
+
+// peel records err's stack and skips wrappers whose message is the same as
+// the single error they wrap.
+func (x *errorExpander) peel(err error) error {
+	for {
+		if stack := stackOf(err); stack != nil {
+			x.stack = stack
+		}
+		next := errors.Unwrap(err)
+		if next == nil || next.Error() != err.Error() || !x.take() {
+			return err
+		}
+		err = next
+	}
+}
+
+// take spends one unit of the unwrap budget, reporting whether any was left.
+func (x *errorExpander) take() bool {
+	if x.budget <= 0 {
+		return false
+	}
+	x.budget--
+	return true
+}
+
+// causes returns the causes of err as array elements. A single-error chain
+// is flattened into consecutive elements; each error of a join becomes an
+// element holding its own causes.
+func (x *errorExpander) causes(err error) []Field {
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		next := u.Unwrap()
+		if next == nil || !x.take() {
+			return nil
+		}
+		next = x.peel(next)
+		return append([]Field{x.cause(next, false)}, x.causes(next)...)
+	case interface{ Unwrap() []error }:
+		var elems []Field
+		for _, next := range u.Unwrap() {
+			if next == nil {
+				continue
+			}
+			if !x.take() {
+				break
+			}
+			next = x.peel(next)
+			elems = append(elems, x.cause(next, true))
+		}
+		return elems
+	}
+	return nil
+}
+
+// cause describes a single error of the chain as an object element.
+func (x *errorExpander) cause(err error, nested bool) Field {
+	fs := []Field{String("message", err.Error()), String("type", errorTypeName(err))}
+	if fielder, ok := err.(ErrorFielder); ok {
+		fs = append(fs, fielder.ErrorFields()...)
+	}
+	if nested {
+		if causes := x.causes(err); len(causes) > 0 {
+			fs = append(fs, Array("causes", causes...))
+		}
+	}
+	return Object("", fs...)
+}
+
+func errorTypeName(err error) string {
+	return fmt.Sprintf("%T", err)
+}
+
+// stackOf returns the program counters carried by err. Besides WithStack it
+// recognizes pkg/errors-style StackTrace methods returning a slice of
+// uintptr-based frames, without depending on those packages.
+func stackOf(err error) []uintptr {
+	if st, ok := err.(stackTracer); ok {
+		return st.StackTrace()
+	}
+
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() {
+		return nil
+	}
+	mt := method.Type()
+	if mt.NumIn() != 0 || mt.NumOut() != 1 {
+		return nil
+	}
+	if out := mt.Out(0); out.Kind() != reflect.Slice || out.Elem().Kind() != reflect.Uintptr {
+		return nil
+	}
+
+	frames := method.Call(nil)[0]
+	if frames.Len() == 0 {
+		return nil
+	}
+	pcs := make([]uintptr, frames.Len())
+	for i := range pcs {
+		pcs[i] = uintptr(frames.Index(i).Uint())
+	}
+	return pcs
+}
+
+// formatStack formats program counters the way zap formats stacktraces:
+// the function on one line and its file and line indented on the next.
+func formatStack(pcs []uintptr) string {
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			if b.Len() > 0 {
+				b.WriteByte('\n')
+			}
+			b.WriteString(frame.Function)
+			b.WriteString("\n\t")
+			b.WriteString(frame.File)
+			b.WriteByte(':')
+			b.WriteString(strconv.Itoa(frame.Line))
+		}
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
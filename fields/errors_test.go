@@ -0,0 +1,154 @@
+package fields
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+type detailedError struct{ code int }
+
+func (e *detailedError) Error() string { return fmt.Sprintf("code %d", e.code) }
+
+func (e *detailedError) ErrorFields() []Field { return []Field{Int("code", e.code)} }
+
+// pkgFrame and pkgStackTrace mirror the pkg/errors stack types.
+type pkgFrame uintptr
+
+type pkgStackTrace []pkgFrame
+
+type pkgError struct {
+	msg   string
+	stack pkgStackTrace
+}
+
+func (e *pkgError) Error() string { return e.msg }
+
+func (e *pkgError) StackTrace() pkgStackTrace { return e.stack }
+
+func newPkgError(msg string) error {
+	var pcs [8]uintptr
+	n := runtime.Callers(1, pcs[:])
+	stack := make(pkgStackTrace, n)
+	for i, pc := range pcs[:n] {
+		stack[i] = pkgFrame(pc)
+	}
+	return &pkgError{msg: msg, stack: stack}
+}
+
+// cyclicError unwraps to itself.
+type cyclicError struct{}
+
+func (e *cyclicError) Error() string { return "cycle" }
+
+func (e *cyclicError) Unwrap() error { return e }
+
+func TestExpandError(t *testing.T) {
+	if got := ExpandError(ErrorField, nil); got != nil {
+		t.Errorf("ExpandError(nil) = %+v, want nil", got)
+	}
+
+	root := errors.New("not found")
+	err := fmt.Errorf("load user: %w", &detailedError{code: 404})
+	err = fmt.Errorf("handler: %w", fmt.Errorf("%w", err))
+
+	got := ExpandError(ErrorField, err)
+	want := []Field{
+		String(ErrorField, "handler: load user: code 404"),
+		String(ErrorTypeField, "*fmt.wrapError"),
+		Array(ErrorCausesField,
+			Object("", String("message", "load user: code 404"), String("type", "*fmt.wrapError")),
+			Object("", String("message", "code 404"), String("type", "*fields.detailedError"), Int("code", 404)),
+		),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandError() = %+v, want %+v", got, want)
+	}
+
+	got = ExpandError("cause", &detailedError{code: 1})
+	want = []Field{
+		String("cause", "code 1"),
+		String("cause_type", "*fields.detailedError"),
+		Int("cause_code", 1),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandError() = %+v, want %+v", got, want)
+	}
+
+	got = ExpandError(ErrorField, errors.Join(root, nil))
+	if len(got) != 3 || got[2].Key != ErrorCausesField || len(got[2].Fields()) != 1 {
+		t.Errorf("ExpandError(join) = %+v", got)
+	}
+}
+
+func TestExpandError_Stack(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		typ  string
+	}{
+		{"with stack", fmt.Errorf("wrapped: %w", WithStack(errors.New("boom"))), "*fmt.wrapError"},
+		{"with stack top level", WithStack(errors.New("boom")), "*errors.errorString"},
+		{"pkg errors", fmt.Errorf("wrapped: %w", newPkgError("boom")), "*fmt.wrapError"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandError(ErrorField, tt.err)
+			if got[1].String != tt.typ {
+				t.Errorf("type = %q, want %q", got[1].String, tt.typ)
+			}
+
+			last := got[len(got)-1]
+			if last.Key != ErrorStackField {
+				t.Fatalf("last field = %+v, want %s", last, ErrorStackField)
+			}
+			if !strings.Contains(last.String, "fields.TestExpandError_Stack") ||
+				!strings.Contains(last.String, "\n\t") ||
+				!strings.Contains(last.String, "errors_test.go:") {
+				t.Errorf("stack = %q", last.String)
+			}
+
+			for _, cause := range got {
+				if cause.Key != ErrorCausesField {
+					continue
+				}
+				for _, elem := range cause.Fields() {
+					if elem.Fields()[0].String == "wrapped: boom" {
+						t.Errorf("stack wrapper listed as cause: %+v", elem)
+					}
+				}
+			}
+		})
+	}
+
+	if WithStack(nil) != nil {
+		t.Error("WithStack(nil) should be nil")
+	}
+}
+
+func TestExpandError_Cycle(t *testing.T) {
+	got := ExpandError(ErrorField, fmt.Errorf("outer: %w", &cyclicError{}))
+	if len(got) != 3 || len(got[2].Fields()) > maxErrorUnwraps {
+		t.Errorf("ExpandError(cycle) = %+v", got)
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	fs := []Field{String("user", "alice"), Int("count", 1)}
+	if got := ExpandErrors(fs); &got[0] != &fs[0] {
+		t.Error("ExpandErrors() should return fields without errors unchanged")
+	}
+
+	got := ExpandErrors([]Field{String("user", "alice"), Err(errors.New("boom")), Object("nested", Err(errors.New("inner")))})
+	var keys []string
+	for _, f := range got {
+		keys = append(keys, f.Key)
+	}
+	if want := []string{"user", ErrorField, ErrorTypeField, "nested"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("ExpandErrors() keys = %v, want %v", keys, want)
+	}
+}
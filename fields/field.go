@@ -0,0 +1,268 @@
+package fields
+
+import (
+	"math"
+	"time"
+)
+
+// FieldType identifies how a Field's value is stored.
+type FieldType uint8
+
+const (
+	// UnknownType is the zero value and is never produced by a constructor.
+	UnknownType FieldType = iota
+	// SkipType marks a field that engines ignore, such as Err(nil).
+	SkipType
+	// StringType stores its value in Field.String.
+	StringType
+	// Int64Type stores its value in Field.Integer.
+	Int64Type
+	// Uint64Type stores its value in Field.Integer as the same bits.
+	Uint64Type
+	// Float64Type stores its value in Field.Integer as IEEE 754 bits.
+	Float64Type
+	// BoolType stores 1 or 0 in Field.Integer.
+	BoolType
+	// DurationType stores nanoseconds in Field.Integer.
+	DurationType
+	// TimeType stores Unix nanoseconds in Field.Integer and the *time.Location in Field.Interface.
+	TimeType
+	// ErrorType stores the error in Field.Interface.
+	ErrorType
+	// AnyType stores an arbitrary value in Field.Interface; engines encode it with their
+	// generic (possibly reflection-based) encoder.
+	AnyType
+	// ObjectType stores nested fields as a []Field in Field.Interface.
+	ObjectType
+	// ArrayType stores elements as a []Field in Field.Interface; element keys are ignored.
+	ArrayType
+	// ObjectMarshalerType stores an ObjectMarshaler in Field.Interface.
+	ObjectMarshalerType
+	// ArrayMarshalerType stores an ArrayMarshaler in Field.Interface.
+	ArrayMarshalerType
+)
+
+// Field is a typed key-value pair. Constructors store scalar values without
+// boxing so engines can map them directly to zap.Field and slog.Attr.
+type Field struct {
+	Key       string
+	Type      FieldType
+	Integer   int64
+	String    string
+	Interface interface{}
+}
+
+// String constructs a field with a string value.
+func String(key, value string) Field {
+	return Field{Key: key, Type: StringType, String: value}
+}
+
+// Int constructs a field with an int value.
+func Int(key string, value int) Field {
+	return Int64(key, int64(value))
+}
+
+// Int64 constructs a field with an int64 value.
+func Int64(key string, value int64) Field {
+	return Field{Key: key, Type: Int64Type, Integer: value}
+}
+
+// Uint64 constructs a field with a uint64 value.
+func Uint64(key string, value uint64) Field {
+	return Field{Key: key, Type: Uint64Type, Integer: int64(value)}
+}
+
+// Float64 constructs a field with a float64 value.
+func Float64(key string, value float64) Field {
+	return Field{Key: key, Type: Float64Type, Integer: int64(math.Float64bits(value))}
+}
+
+// Bool constructs a field with a bool value.
+func Bool(key string, value bool) Field {
+	var b int64
+	if value {
+		b = 1
+	}
+	return Field{Key: key, Type: BoolType, Integer: b}
+}
+
+// Duration constructs a field with a time.Duration value.
+func Duration(key string, value time.Duration) Field {
+	return Field{Key: key, Type: DurationType, Integer: int64(value)}
+}
+
+// minTime and maxTime bound the times representable as Unix nanoseconds
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
+// Time constructs a field with a time.Time value. Times outside the range
+// of Unix nanoseconds, such as the zero time, are stored as AnyType.
+func Time(key string, value time.Time) Field {
+	if value.Before(minTime) || value.After(maxTime) {
+		return Field{Key: key, Type: AnyType, Interface: value}
+	}
+	return Field{Key: key, Type: TimeType, Integer: value.UnixNano(), Interface: value.Location()}
+}
+
+// Err constructs a field with the standard error key. A nil error produces
+// a field that is skipped.
+func Err(err error) Field {
+	return NamedErr(ErrorField, err)
+}
+
+// NamedErr constructs an error field with the given key. A nil error
+// produces a field that is skipped.
+func NamedErr(key string, err error) Field {
+	if err == nil {
+		return Field{Key: key, Type: SkipType}
+	}
+	return Field{Key: key, Type: ErrorType, Interface: err}
+}
+
+// Object constructs a field whose value is an object built from nested fields.
+func Object(key string, fields ...Field) Field {
+	return Field{Key: key, Type: ObjectType, Interface: fields}
+}
+
+// Array constructs a field whose value is an array of the given element
+// fields. Element keys are ignored and may be empty.
+func Array(key string, elems ...Field) Field {
+	return Field{Key: key, Type: ArrayType, Interface: elems}
+}
+
+// ObjectOf constructs a field whose value is the object marshaled by m.
+// Engines call m.LogObject only when the entry is written.
+func ObjectOf(key string, m ObjectMarshaler) Field {
+	return Field{Key: key, Type: ObjectMarshalerType, Interface: m}
+}
+
+// ArrayOf constructs a field whose value is the array marshaled by m.
+// Engines call m.LogArray only when the entry is written.
+func ArrayOf(key string, m ArrayMarshaler) Field {
+	return Field{Key: key, Type: ArrayMarshalerType, Interface: m}
+}
+
+// Any constructs a field from an arbitrary value, choosing a typed
+// representation for common types and falling back to AnyType.
+func Any(key string, value interface{}) Field {
+	switch v := value.(type) {
+	case Field:
+		v.Key = key
+		return v
+	case string:
+		return String(key, v)
+	case int:
+		return Int(key, v)
+	case int64:
+		return Int64(key, v)
+	case int32:
+		return Int64(key, int64(v))
+	case int16:
+		return Int64(key, int64(v))
+	case int8:
+		return Int64(key, int64(v))
+	case uint:
+		return Uint64(key, uint64(v))
+	case uint64:
+		return Uint64(key, v)
+	case uint32:
+		return Uint64(key, uint64(v))
+	case uint16:
+		return Uint64(key, uint64(v))
+	case uint8:
+		return Uint64(key, uint64(v))
+	case float64:
+		return Float64(key, v)
+	case float32:
+		return Float64(key, float64(v))
+	case bool:
+		return Bool(key, v)
+	case time.Duration:
+		return Duration(key, v)
+	case time.Time:
+		return Time(key, v)
+	case error:
+		return NamedErr(key, v)
+	case ObjectMarshaler:
+		return ObjectOf(key, v)
+	case ArrayMarshaler:
+		return ArrayOf(key, v)
+	default:
+		return Field{Key: key, Type: AnyType, Interface: value}
+	}
+}
+
+// Float64Value returns the value of a Float64Type field.
+func (f Field) Float64Value() float64 {
+	return math.Float64frombits(uint64(f.Integer))
+}
+
+// TimeValue returns the value of a TimeType field.
+func (f Field) TimeValue() time.Time {
+	t := time.Unix(0, f.Integer)
+	if loc, ok := f.Interface.(*time.Location); ok && loc != nil {
+		return t.In(loc)
+	}
+	return t
+}
+
+// Fields returns the nested fields of an ObjectType or ArrayType field.
+func (f Field) Fields() []Field {
+	fields, _ := f.Interface.([]Field)
+	return fields
+}
+
+// Value returns the field's value as an interface{}. Objects and object
+// marshalers become map[string]interface{}, arrays and array marshalers
+// become []interface{} and errors their message.
+// It allocates, so engines only use it where no typed encoding exists.
+func (f Field) Value() interface{} {
+	switch f.Type {
+	case StringType:
+		return f.String
+	case Int64Type:
+		return f.Integer
+	case Uint64Type:
+		return uint64(f.Integer)
+	case Float64Type:
+		return f.Float64Value()
+	case BoolType:
+		return f.Integer == 1
+	case DurationType:
+		return time.Duration(f.Integer)
+	case TimeType:
+		return f.TimeValue()
+	case ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return err.Error()
+		}
+		return f.Interface
+	case ObjectType:
+		nested := f.Fields()
+		m := make(map[string]interface{}, len(nested))
+		for _, n := range nested {
+			if n.Type != SkipType {
+				m[n.Key] = n.Value()
+			}
+		}
+		return m
+	case ArrayType:
+		elems := f.Fields()
+		values := make([]interface{}, 0, len(elems))
+		for _, e := range elems {
+			if e.Type != SkipType {
+				values = append(values, e.Value())
+			}
+		}
+		return values
+	case ObjectMarshalerType, ArrayMarshalerType:
+		var r marshalResolver
+		return r.field(f, 0).Value()
+	case SkipType:
+		return nil
+	default:
+		return f.Interface
+	}
+}
@@ -0,0 +1,155 @@
+package fields
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFieldConstructors(t *testing.T) {
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		field    Field
+		wantType FieldType
+		want     interface{}
+	}{
+		{"string", String("k", "v"), StringType, "v"},
+		{"int", Int("k", 42), Int64Type, int64(42)},
+		{"int64", Int64("k", -7), Int64Type, int64(-7)},
+		{"uint64", Uint64("k", 1<<63), Uint64Type, uint64(1 << 63)},
+		{"float64", Float64("k", 0.25), Float64Type, 0.25},
+		{"bool true", Bool("k", true), BoolType, true},
+		{"bool false", Bool("k", false), BoolType, false},
+		{"duration", Duration("k", 1500*time.Millisecond), DurationType, 1500 * time.Millisecond},
+		{"time", Time("k", at), TimeType, at},
+		{"error", NamedErr("k", errors.New("boom")), ErrorType, "boom"},
+		{"nil error", NamedErr("k", nil), SkipType, nil},
+		{"object", Object("k", String("a", "b"), Int("c", 1)), ObjectType, map[string]interface{}{"a": "b", "c": int64(1)}},
+		{"array", Array("k", String("", "x"), Bool("", true)), ArrayType, []interface{}{"x", true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.field.Key != "k" {
+				t.Errorf("Key = %q, want %q", tt.field.Key, "k")
+			}
+			if tt.field.Type != tt.wantType {
+				t.Errorf("Type = %v, want %v", tt.field.Type, tt.wantType)
+			}
+			if got := tt.field.Value(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErr(t *testing.T) {
+	f := Err(errors.New("boom"))
+	if f.Key != ErrorField || f.Type != ErrorType {
+		t.Errorf("Err() = %+v, want key %q and ErrorType", f, ErrorField)
+	}
+
+	if f := Err(nil); f.Type != SkipType {
+		t.Errorf("Err(nil).Type = %v, want SkipType", f.Type)
+	}
+}
+
+func TestTime_OutOfRange(t *testing.T) {
+	f := Time("k", time.Time{})
+	if f.Type != AnyType {
+		t.Fatalf("Time(zero).Type = %v, want AnyType", f.Type)
+	}
+	if got := f.Value(); got != (time.Time{}) {
+		t.Errorf("Time(zero).Value() = %v, want zero time", got)
+	}
+}
+
+func TestTime_PreservesLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	at := time.Date(2026, 10, 18, 20, 0, 0, 0, loc)
+
+	got := Time("k", at).TimeValue()
+	if !got.Equal(at) || got.Location() != loc {
+		t.Errorf("TimeValue() = %v, want %v", got, at)
+	}
+}
+
+func TestAny(t *testing.T) {
+	type custom struct{ A int }
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		wantType FieldType
+	}{
+		{"string", "v", StringType},
+		{"int", 1, Int64Type},
+		{"int32", int32(1), Int64Type},
+		{"uint", uint(1), Uint64Type},
+		{"uint8", uint8(1), Uint64Type},
+		{"float32", float32(1.5), Float64Type},
+		{"bool", true, BoolType},
+		{"duration", time.Second, DurationType},
+		{"time", time.Unix(0, 0), TimeType},
+		{"error", errors.New("boom"), ErrorType},
+		{"field", Int("other", 3), Int64Type},
+		{"struct", custom{A: 1}, AnyType},
+		{"nil", nil, AnyType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Any("k", tt.value)
+			if f.Key != "k" {
+				t.Errorf("Key = %q, want %q", f.Key, "k")
+			}
+			if f.Type != tt.wantType {
+				t.Errorf("Type = %v, want %v", f.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestValue_SkipsNestedSkipFields(t *testing.T) {
+	obj := Object("k", String("a", "b"), Err(nil))
+	if got := obj.Value().(map[string]interface{}); len(got) != 1 {
+		t.Errorf("object Value() = %v, want only key a", got)
+	}
+
+	arr := Array("k", Int("", 1), Err(nil))
+	if got := arr.Value().([]interface{}); len(got) != 1 {
+		t.Errorf("array Value() = %v, want one element", got)
+	}
+}
+
+func TestFieldMapper_StandardName(t *testing.T) {
+	mapper := NewFieldMapper()
+
+	tests := map[string]string{
+		"traceId":    TraceIDField,
+		"trace.id":   TraceIDField,
+		"request_id": RequestIDField,
+		"custom":     "custom",
+	}
+	for in, want := range tests {
+		if got := mapper.StandardName(in); got != want {
+			t.Errorf("StandardName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFieldConstructors_DoNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = String("k", "v")
+		_ = Int("k", 1)
+		_ = Float64("k", 1.5)
+		_ = Bool("k", true)
+		_ = Duration("k", time.Second)
+	})
+	if allocs != 0 {
+		t.Errorf("scalar constructors allocated %v times, want 0", allocs)
+	}
+}
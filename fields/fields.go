@@ -8,15 +8,18 @@ const (
 	LevelField     = "level"
 	MessageField   = "message"
 	CallerField    = "caller"
+	LoggerField    = "logger"
 
 	// Tracing fields
 	TraceIDField = "trace_id"
 	SpanIDField  = "span_id"
 
 	// Error fields
-	ErrorField      = "error"
-	ErrorTypeField  = "error_type"
-	StacktraceField = "stacktrace"
+	ErrorField       = "error"
+	ErrorTypeField   = "error_type"
+	ErrorCausesField = "error_causes"
+	ErrorStackField  = "error_stack"
+	StacktraceField  = "stacktrace"
 
 	// Service identification fields
 	ServiceField     = "service"
@@ -33,13 +36,26 @@ const (
 	LatencyField  = "latency"
 )
 
+// standardFieldNames lists every standard field name.
+var standardFieldNames = []string{
+	TimestampField, LevelField, MessageField, CallerField, LoggerField,
+	TraceIDField, SpanIDField, ErrorField, ErrorTypeField, ErrorCausesField,
+	ErrorStackField, StacktraceField,
+	ServiceField, ServiceVersion, EnvironmentField,
+	RequestIDField, UserIDField, SessionIDField,
+	DurationField, LatencyField,
+}
+
 // FieldMapper provides methods to ensure consistent field mapping
 // across different logging engines.
-type FieldMapper struct{}
+type FieldMapper struct {
+	scheme NamingScheme
+	names  map[string]string
+}
 
-// NewFieldMapper creates a new field mapper instance.
+// NewFieldMapper creates a new field mapper instance using DefaultScheme.
 func NewFieldMapper() *FieldMapper {
-	return &FieldMapper{}
+	return &FieldMapper{scheme: DefaultScheme}
 }
 
 // MapCoreFields maps common fields to their standardized names.
@@ -69,17 +85,34 @@ func (fm *FieldMapper) MapTracingFields() map[string]string {
 	}
 }
 
-// ValidateFieldName checks if a field name follows the standardized naming convention.
-func (fm *FieldMapper) ValidateFieldName(fieldName string) bool {
-	standardFields := []string{
-		TimestampField, LevelField, MessageField, CallerField,
-		TraceIDField, SpanIDField, ErrorField, ErrorTypeField, StacktraceField,
-		ServiceField, ServiceVersion, EnvironmentField,
-		RequestIDField, UserIDField, SessionIDField,
-		DurationField, LatencyField,
+// standardNames merges the core and tracing mappings once so typed fields
+// can be standardized without rebuilding the maps.
+var standardNames = func() map[string]string {
+	fm := &FieldMapper{}
+	names := fm.MapCoreFields()
+	for k, v := range fm.MapTracingFields() {
+		names[k] = v
 	}
+	return names
+}()
 
-	for _, field := range standardFields {
+// StandardName returns the name fieldName is written as under the mapper's
+// naming scheme, or fieldName itself when no mapping exists. Unlike the
+// Map* methods it does not allocate.
+func (fm *FieldMapper) StandardName(fieldName string) string {
+	names := standardNames
+	if fm != nil && fm.names != nil {
+		names = fm.names
+	}
+	if mapped, exists := names[fieldName]; exists {
+		return mapped
+	}
+	return fieldName
+}
+
+// ValidateFieldName checks if a field name follows the standardized naming convention.
+func (fm *FieldMapper) ValidateFieldName(fieldName string) bool {
+	for _, field := range standardFieldNames {
 		if field == fieldName {
 			return true
 		}
@@ -0,0 +1,405 @@
+package fields
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// TruncatedField is the key of the object added to an entry that Limits cut.
+// It holds "message", the original message length in bytes; "values", the
+// keys of cut values; "depth", the keys of objects and arrays replaced by
+// DepthValue; "fields", the number of fields dropped beyond MaxFields; and
+// "record", the keys of fields dropped to fit MaxRecordBytes. Nested keys are
+// joined with "." and array elements written as "[i]".
+const TruncatedField = "_truncated"
+
+// Limits bounds the size of log entries. Zero values are unlimited and a nil
+// *Limits applies no limits.
+type Limits struct {
+	// MaxMessageLength is the maximum message length in bytes.
+	MaxMessageLength int
+
+	// MaxValueLength is the maximum length in bytes of a string, an error
+	// message or the JSON encoding of an AnyType value, at any depth.
+	MaxValueLength int
+
+	// MaxFields is the maximum number of top-level fields; later fields are
+	// dropped.
+	MaxFields int
+
+	// MaxDepth is the maximum nesting of objects and arrays; deeper ones are
+	// written as DepthValue.
+	MaxDepth int
+
+	// MaxRecordBytes is the maximum size of the message and fields,
+	// estimated from their JSON encoding. Fields that do not fit are dropped,
+	// and the message is cut when it does not fit by itself.
+	MaxRecordBytes int
+}
+
+// Enabled reports whether any limit is set.
+func (l *Limits) Enabled() bool {
+	return l != nil && (l.MaxMessageLength > 0 || l.MaxValueLength > 0 ||
+		l.MaxFields > 0 || l.MaxDepth > 0 || l.MaxRecordBytes > 0)
+}
+
+// Validate reports a negative limit.
+func (l *Limits) Validate() error {
+	if l == nil {
+		return nil
+	}
+	for name, value := range map[string]int{
+		"max message length": l.MaxMessageLength,
+		"max value length":   l.MaxValueLength,
+		"max fields":         l.MaxFields,
+		"max depth":          l.MaxDepth,
+		"max record bytes":   l.MaxRecordBytes,
+	} {
+		if value < 0 {
+			return fmt.Errorf("invalid %s %d", name, value)
+		}
+	}
+	return nil
+}
+
+// Apply returns msg and fs cut to the limits, with a TruncatedField object
+// appended when anything was cut. Errors are expanded and marshalers
+// resolved first so that their fields are limited as well. The
+// TruncatedField object itself is not counted against the limits. msg and fs
+// are returned unchanged when no limit is set.
+func (l *Limits) Apply(msg string, fs []Field) (string, []Field) {
+	return l.ApplyEntry(ContextUsage{}, msg, fs)
+}
+
+// ContextUsage is the share of the per-entry limits taken by the fields a
+// logger adds with With, together with what was cut from them. The zero value
+// is a logger without context fields.
+type ContextUsage struct {
+	fields int
+	bytes  int
+	cut    truncation
+}
+
+// Marker returns the TruncatedField object describing what was cut from the
+// context, for entries written without fields of their own.
+func (u ContextUsage) Marker() (Field, bool) {
+	return u.cut.marker()
+}
+
+// ApplyContext limits fs, the fields added by With to a logger whose context
+// has usage, and returns them with the usage of the new context. The context
+// counts against MaxFields and MaxRecordBytes of every entry, so fields
+// beyond either budget are dropped here. What was cut is not added to fs but
+// reported by ApplyEntry in the TruncatedField object of each entry.
+func (l *Limits) ApplyContext(usage ContextUsage, fs []Field) ([]Field, ContextUsage) {
+	if !l.Enabled() {
+		return fs, usage
+	}
+	t := truncation{limits: l}
+	_, fs = t.apply(usage, "", fs, 0)
+	usage.fields += len(fs)
+	for _, f := range fs {
+		usage.bytes += fieldSize(f)
+	}
+	usage.cut = usage.cut.merge(t)
+	return fs, usage
+}
+
+// ApplyEntry is Apply for an entry of a logger whose context has usage. The
+// context fields come first in the MaxFields and MaxRecordBytes budgets, and
+// a single TruncatedField object reports what was cut from the context and
+// from the entry.
+func (l *Limits) ApplyEntry(usage ContextUsage, msg string, fs []Field) (string, []Field) {
+	if !l.Enabled() {
+		return msg, fs
+	}
+	t := usage.cut.merge(truncation{})
+	t.limits = l
+	msg = t.message(msg, l.messageLimit())
+	msg, fs = t.apply(usage, msg, fs, len(msg)+2)
+
+	if marker, ok := t.marker(); ok {
+		fs = append(fs[:len(fs):len(fs)], marker)
+	}
+	return msg, fs
+}
+
+// apply cuts fs to what is left of the limits after usage, with used bytes of
+// the record already taken by the message.
+func (t *truncation) apply(usage ContextUsage, msg string, fs []Field, used int) (string, []Field) {
+	l := t.limits
+	fs = ResolveMarshalers(ExpandErrors(fs))
+
+	if l.MaxFields > 0 {
+		kept := make([]Field, 0, len(fs))
+		for _, f := range fs {
+			switch {
+			case f.Type == SkipType:
+			case usage.fields+len(kept) < l.MaxFields:
+				kept = append(kept, f)
+			default:
+				t.dropped++
+			}
+		}
+		fs = kept
+	}
+	if l.MaxValueLength > 0 || l.MaxDepth > 0 {
+		fs = t.fields(fs, "", 0)
+	}
+	if l.MaxRecordBytes > 0 {
+		msg, fs = t.record(msg, fs, used+usage.bytes)
+	}
+	return msg, fs
+}
+
+// TruncateMessage returns msg cut to MaxMessageLength, or to MaxRecordBytes
+// when that is smaller, and when it was cut a TruncatedField object holding
+// its original length. It is used for entries logged without fields.
+func (l *Limits) TruncateMessage(msg string) (string, []Field) {
+	if l == nil {
+		return msg, nil
+	}
+	t := truncation{limits: l}
+	msg = t.message(msg, l.messageLimit())
+	if marker, ok := t.marker(); ok {
+		return msg, []Field{marker}
+	}
+	return msg, nil
+}
+
+// messageLimit returns the smaller of MaxMessageLength and MaxRecordBytes,
+// or 0 when neither is set.
+func (l *Limits) messageLimit() int {
+	limit := l.MaxMessageLength
+	if l.MaxRecordBytes > 0 && (limit == 0 || l.MaxRecordBytes < limit) {
+		limit = l.MaxRecordBytes
+	}
+	return limit
+}
+
+// truncation applies limits to one entry and records what was cut.
+type truncation struct {
+	limits     *Limits
+	messageLen int
+	valueKeys  []string
+	depthKeys  []string
+	dropped    int
+	recordKeys []string
+}
+
+// message cuts msg to limit bytes, remembering its original length.
+func (t *truncation) message(msg string, limit int) string {
+	if limit <= 0 || len(msg) <= limit {
+		return msg
+	}
+	if t.messageLen == 0 {
+		t.messageLen = len(msg)
+	}
+	return truncateString(msg, limit)
+}
+
+// fields applies the value length and depth limits to fs, nested depth
+// levels deep under path.
+func (t *truncation) fields(fs []Field, path string, depth int) []Field {
+	limited := make([]Field, len(fs))
+	for i, f := range fs {
+		limited[i] = t.field(f, joinPath(path, f.Key), depth)
+	}
+	return limited
+}
+
+func (t *truncation) field(f Field, path string, depth int) Field {
+	maxLen := t.limits.MaxValueLength
+	switch f.Type {
+	case StringType:
+		if maxLen > 0 && len(f.String) > maxLen {
+			t.valueKeys = append(t.valueKeys, path)
+			return String(f.Key, truncateString(f.String, maxLen))
+		}
+	case ErrorType:
+		if err, ok := f.Interface.(error); ok && maxLen > 0 && len(err.Error()) > maxLen {
+			t.valueKeys = append(t.valueKeys, path)
+			return String(f.Key, truncateString(err.Error(), maxLen))
+		}
+	case ObjectType, ArrayType:
+		if t.limits.MaxDepth > 0 && depth >= t.limits.MaxDepth {
+			t.depthKeys = append(t.depthKeys, path)
+			return String(f.Key, DepthValue)
+		}
+		nested := f.Fields()
+		if f.Type == ArrayType {
+			elems := make([]Field, len(nested))
+			for i, e := range nested {
+				elems[i] = t.field(e, path+"["+strconv.Itoa(i)+"]", depth+1)
+			}
+			return Field{Key: f.Key, Type: ArrayType, Interface: elems}
+		}
+		return Field{Key: f.Key, Type: ObjectType, Interface: t.fields(nested, path, depth+1)}
+	case AnyType:
+		if maxLen > 0 && f.Interface != nil {
+			if encoded := encodeAny(f.Interface); len(encoded) > maxLen {
+				t.valueKeys = append(t.valueKeys, path)
+				return String(f.Key, truncateString(encoded, maxLen))
+			}
+		}
+	}
+	return f
+}
+
+// record keeps the fields that fit in MaxRecordBytes together with msg,
+// cutting msg first when it does not fit by itself. used bytes of the record
+// are already taken.
+func (t *truncation) record(msg string, fs []Field, used int) (string, []Field) {
+	budget := t.limits.MaxRecordBytes
+	msg = t.message(msg, budget)
+
+	var kept []Field
+	for i, f := range fs {
+		size := fieldSize(f)
+		if used+size > budget {
+			if kept == nil {
+				kept = append(make([]Field, 0, len(fs)), fs[:i]...)
+			}
+			t.recordKeys = append(t.recordKeys, f.Key)
+			continue
+		}
+		used += size
+		if kept != nil {
+			kept = append(kept, f)
+		}
+	}
+	if kept == nil {
+		return msg, fs
+	}
+	return msg, kept
+}
+
+// merge returns what was cut by t and then by other, without sharing the
+// key slices of t.
+func (t truncation) merge(other truncation) truncation {
+	merged := truncation{
+		limits:     t.limits,
+		messageLen: t.messageLen,
+		valueKeys:  append(t.valueKeys[:len(t.valueKeys):len(t.valueKeys)], other.valueKeys...),
+		depthKeys:  append(t.depthKeys[:len(t.depthKeys):len(t.depthKeys)], other.depthKeys...),
+		dropped:    t.dropped + other.dropped,
+		recordKeys: append(t.recordKeys[:len(t.recordKeys):len(t.recordKeys)], other.recordKeys...),
+	}
+	if merged.messageLen == 0 {
+		merged.messageLen = other.messageLen
+	}
+	return merged
+}
+
+// marker returns the TruncatedField object describing what was cut.
+func (t *truncation) marker() (Field, bool) {
+	var fs []Field
+	if t.messageLen > 0 {
+		fs = append(fs, Int("message", t.messageLen))
+	}
+	if len(t.valueKeys) > 0 {
+		fs = append(fs, Array("values", stringElems(t.valueKeys)...))
+	}
+	if len(t.depthKeys) > 0 {
+		fs = append(fs, Array("depth", stringElems(t.depthKeys)...))
+	}
+	if t.dropped > 0 {
+		fs = append(fs, Int("fields", t.dropped))
+	}
+	if len(t.recordKeys) > 0 {
+		fs = append(fs, Array("record", stringElems(t.recordKeys)...))
+	}
+	if len(fs) == 0 {
+		return Field{}, false
+	}
+	return Object(TruncatedField, fs...), true
+}
+
+// fieldSize estimates the JSON encoding of f as an object member, including
+// its separator.
+func fieldSize(f Field) int {
+	if f.Type == SkipType {
+		return 0
+	}
+	return len(f.Key) + 4 + valueSize(f)
+}
+
+// valueSize estimates the JSON encoding of f's value. Durations are counted
+// in their string form and times in RFC 3339 with nanoseconds.
+func valueSize(f Field) int {
+	switch f.Type {
+	case StringType:
+		return len(f.String) + 2
+	case Int64Type:
+		return len(strconv.FormatInt(f.Integer, 10))
+	case Uint64Type:
+		return len(strconv.FormatUint(uint64(f.Integer), 10))
+	case Float64Type:
+		return len(strconv.FormatFloat(f.Float64Value(), 'g', -1, 64))
+	case BoolType:
+		return 5
+	case DurationType:
+		return len(time.Duration(f.Integer).String()) + 2
+	case TimeType:
+		return len(time.RFC3339Nano) + 2
+	case ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return len(err.Error()) + 2
+		}
+	case ObjectType:
+		size := 2
+		for _, n := range f.Fields() {
+			size += fieldSize(n)
+		}
+		return size
+	case ArrayType:
+		size := 2
+		for _, e := range f.Fields() {
+			if e.Type != SkipType {
+				size += valueSize(e) + 1
+			}
+		}
+		return size
+	case SkipType:
+		return 0
+	}
+	return len(encodeAny(f.Interface))
+}
+
+// encodeAny returns the JSON encoding of v, falling back to fmt formatting
+// for values that cannot be encoded.
+func encodeAny(v interface{}) string {
+	if data, err := json.Marshal(v); err == nil {
+		return string(data)
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// truncateString cuts s to at most n bytes without splitting a UTF-8 sequence.
+func truncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func stringElems(values []string) []Field {
+	elems := make([]Field, len(values))
+	for i, v := range values {
+		elems[i] = String("", v)
+	}
+	return elems
+}
@@ -0,0 +1,184 @@
+package fields
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func truncated(fs ...Field) Field {
+	return Object(TruncatedField, fs...)
+}
+
+func TestLimits_Apply(t *testing.T) {
+	long := strings.Repeat("x", 20)
+
+	tests := []struct {
+		name    string
+		limits  *Limits
+		msg     string
+		in      []Field
+		wantMsg string
+		want    []Field
+	}{
+		{
+			name:    "nil limits",
+			msg:     long,
+			in:      []Field{String("body", long)},
+			wantMsg: long,
+			want:    []Field{String("body", long)},
+		},
+		{
+			name:    "within limits",
+			limits:  &Limits{MaxMessageLength: 20, MaxValueLength: 20, MaxFields: 1},
+			msg:     long,
+			in:      []Field{String("body", long)},
+			wantMsg: long,
+			want:    []Field{String("body", long)},
+		},
+		{
+			name:    "message",
+			limits:  &Limits{MaxMessageLength: 5},
+			msg:     long,
+			wantMsg: "xxxxx",
+			want:    []Field{truncated(Int("message", 20))},
+		},
+		{
+			name:    "message keeps utf-8 sequences whole",
+			limits:  &Limits{MaxMessageLength: 4},
+			msg:     "héllo",
+			wantMsg: "hél",
+			want:    []Field{truncated(Int("message", 6))},
+		},
+		{
+			name:   "values at any depth",
+			limits: &Limits{MaxValueLength: 3},
+			in: []Field{
+				String("body", long),
+				Object("req", String("path", "/a/b")),
+				Array("tags", String("", "ok"), String("", "long")),
+				Any("blob", []int{1, 2, 3}),
+				Object("inner", NamedErr("err", errors.New("boom"))),
+			},
+			want: []Field{
+				String("body", "xxx"),
+				Object("req", String("path", "/a/")),
+				Array("tags", String("", "ok"), String("", "lon")),
+				String("blob", "[1,"),
+				Object("inner", String("err", "boo")),
+				truncated(Array("values",
+					String("", "body"), String("", "req.path"), String("", "tags[1]"),
+					String("", "blob"), String("", "inner.err"),
+				)),
+			},
+		},
+		{
+			name:   "fields",
+			limits: &Limits{MaxFields: 2},
+			in:     []Field{Int("a", 1), {Type: SkipType}, Int("b", 2), Int("c", 3), Int("d", 4)},
+			want:   []Field{Int("a", 1), Int("b", 2), truncated(Int("fields", 2))},
+		},
+		{
+			name:   "depth",
+			limits: &Limits{MaxDepth: 1},
+			in: []Field{
+				Object("a", Object("b", Int("c", 1)), Int("d", 2)),
+				Array("e", Array("", Int("", 1))),
+			},
+			want: []Field{
+				Object("a", String("b", DepthValue), Int("d", 2)),
+				Array("e", String("", DepthValue)),
+				truncated(Array("depth", String("", "a.b"), String("", "e[0]"))),
+			},
+		},
+		{
+			name:    "record keeps the fields that fit",
+			limits:  &Limits{MaxRecordBytes: 40},
+			msg:     "hello",
+			in:      []Field{String("user", "alice"), String("body", long), Int("n", 1)},
+			wantMsg: "hello",
+			want:    []Field{String("user", "alice"), Int("n", 1), truncated(Array("record", String("", "body")))},
+		},
+		{
+			name:    "record cuts a message that does not fit",
+			limits:  &Limits{MaxRecordBytes: 10},
+			msg:     long,
+			in:      []Field{Int("n", 1)},
+			wantMsg: "xxxxxxxxxx",
+			want:    []Field{truncated(Int("message", 20), Array("record", String("", "n")))},
+		},
+		{
+			name:   "errors are expanded first",
+			limits: &Limits{MaxValueLength: 4},
+			in:     []Field{Err(errors.New("boom!"))},
+			want: []Field{
+				String(ErrorField, "boom"),
+				String(ErrorTypeField, "*err"),
+				truncated(Array("values", String("", ErrorField), String("", ErrorTypeField))),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, got := tt.limits.Apply(tt.msg, tt.in)
+			if msg != tt.wantMsg {
+				t.Errorf("Apply() message = %q, want %q", msg, tt.wantMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() fields = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimits_ApplyKeepsInput(t *testing.T) {
+	in := make([]Field, 2, 3)
+	in[0], in[1] = String("a", "long value"), String("b", "x")
+	limits := &Limits{MaxValueLength: 4}
+
+	limits.Apply("", in)
+	if in[0].String != "long value" || in[:3][2].Key != "" {
+		t.Errorf("Apply() modified its input: %+v", in[:3])
+	}
+}
+
+func TestLimits_ApplyEntryCountsContext(t *testing.T) {
+	limits := &Limits{MaxFields: 2}
+
+	context, usage := limits.ApplyContext(ContextUsage{}, []Field{Int("a", 1), Int("b", 2), Int("c", 3)})
+	if want := []Field{Int("a", 1), Int("b", 2)}; !reflect.DeepEqual(context, want) {
+		t.Errorf("ApplyContext() fields = %+v, want %+v", context, want)
+	}
+
+	_, got := limits.ApplyEntry(usage, "m", []Field{Int("d", 4)})
+	if want := []Field{truncated(Int("fields", 2))}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ApplyEntry() fields = %+v, want %+v", got, want)
+	}
+}
+
+func TestLimits_TruncateMessage(t *testing.T) {
+	limits := &Limits{MaxMessageLength: 10, MaxRecordBytes: 4}
+
+	msg, marker := limits.TruncateMessage("hello world")
+	if msg != "hell" {
+		t.Errorf("TruncateMessage() = %q, want %q", msg, "hell")
+	}
+	if want := []Field{truncated(Int("message", 11))}; !reflect.DeepEqual(marker, want) {
+		t.Errorf("TruncateMessage() marker = %+v, want %+v", marker, want)
+	}
+
+	if msg, marker := limits.TruncateMessage("hi"); msg != "hi" || marker != nil {
+		t.Errorf("TruncateMessage() = %q, %+v; want the message unchanged", msg, marker)
+	}
+}
+
+func TestLimits_Validate(t *testing.T) {
+	if err := (&Limits{MaxFields: 10}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := (&Limits{MaxDepth: -1}).Validate(); err == nil {
+		t.Error("Validate() expected an error for a negative limit")
+	}
+}
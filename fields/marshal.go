@@ -0,0 +1,118 @@
+package fields
+
+import "reflect"
+
+// maxMarshalDepth bounds how deeply objects and arrays are nested when
+// marshalers are resolved.
+const maxMarshalDepth = 32
+
+const (
+	// CycleValue replaces a marshaler that is already being marshaled by
+	// one of its parents.
+	CycleValue = "!CYCLE"
+	// DepthValue replaces an object or array nested deeper than 32 levels,
+	// or than Limits.MaxDepth.
+	DepthValue = "!DEPTH"
+)
+
+// ObjectMarshaler is implemented by types that log themselves as a nested
+// object. Like slog.LogValuer it returns the value instead of writing to an
+// encoder, and its method name differs from zapcore.ObjectMarshaler's so a
+// type can implement both.
+type ObjectMarshaler interface {
+	LogObject() []Field
+}
+
+// ArrayMarshaler is implemented by types that log themselves as an array.
+// Element keys are ignored.
+type ArrayMarshaler interface {
+	LogArray() []Field
+}
+
+// ResolveMarshalers returns fs with every ObjectMarshaler and ArrayMarshaler
+// field, at any depth, replaced by the object or array it marshals. A
+// marshaler reached again through its own fields is written as CycleValue
+// and nesting deeper than the limit as DepthValue; a nil pointer marshaler
+// is written as null. fs is returned unchanged when it holds no marshalers.
+func ResolveMarshalers(fs []Field) []Field {
+	if !hasMarshalers(fs, 0) {
+		return fs
+	}
+	var r marshalResolver
+	return r.fields(fs, 0)
+}
+
+// hasMarshalers reports whether fs holds a marshaler field. Fields nested
+// beyond the depth limit are reported as well so that they get truncated.
+func hasMarshalers(fs []Field, depth int) bool {
+	for _, f := range fs {
+		switch f.Type {
+		case ObjectMarshalerType, ArrayMarshalerType:
+			return true
+		case ObjectType, ArrayType:
+			if depth >= maxMarshalDepth || hasMarshalers(f.Fields(), depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// marshalerID identifies a marshaler by its type and the address it refers to.
+type marshalerID struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// marshalResolver resolves marshalers, tracking those being marshaled.
+type marshalResolver struct {
+	path []marshalerID
+}
+
+func (r *marshalResolver) fields(fs []Field, depth int) []Field {
+	resolved := make([]Field, len(fs))
+	for i, f := range fs {
+		resolved[i] = r.field(f, depth)
+	}
+	return resolved
+}
+
+func (r *marshalResolver) field(f Field, depth int) Field {
+	switch f.Type {
+	case ObjectType, ArrayType:
+		if depth >= maxMarshalDepth {
+			return String(f.Key, DepthValue)
+		}
+		return Field{Key: f.Key, Type: f.Type, Interface: r.fields(f.Fields(), depth+1)}
+	case ObjectMarshalerType, ArrayMarshalerType:
+		if depth >= maxMarshalDepth {
+			return String(f.Key, DepthValue)
+		}
+
+		// Only reference kinds can lead back to themselves
+		v := reflect.ValueOf(f.Interface)
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Slice:
+			if v.IsNil() && v.Kind() == reflect.Ptr {
+				return Field{Key: f.Key, Type: AnyType}
+			}
+			id := marshalerID{typ: v.Type(), ptr: v.Pointer()}
+			for _, seen := range r.path {
+				if seen == id {
+					return String(f.Key, CycleValue)
+				}
+			}
+			r.path = append(r.path, id)
+			defer func() { r.path = r.path[:len(r.path)-1] }()
+		}
+
+		if m, ok := f.Interface.(ObjectMarshaler); ok && f.Type == ObjectMarshalerType {
+			return Field{Key: f.Key, Type: ObjectType, Interface: r.fields(m.LogObject(), depth+1)}
+		}
+		if m, ok := f.Interface.(ArrayMarshaler); ok {
+			return Field{Key: f.Key, Type: ArrayType, Interface: r.fields(m.LogArray(), depth+1)}
+		}
+		return Field{Key: f.Key, Type: AnyType, Interface: f.Interface}
+	}
+	return f
+}
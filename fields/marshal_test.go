@@ -0,0 +1,121 @@
+package fields
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testUser struct {
+	Name    string
+	Manager *testUser
+}
+
+func (u *testUser) LogObject() []Field {
+	fs := []Field{String("name", u.Name)}
+	if u.Manager != nil {
+		fs = append(fs, Any("manager", u.Manager))
+	}
+	return fs
+}
+
+type testTags []string
+
+func (t testTags) LogArray() []Field {
+	elems := make([]Field, len(t))
+	for i, tag := range t {
+		elems[i] = String("", tag)
+	}
+	return elems
+}
+
+// testNode nests a new node on every call, without ever repeating itself.
+type testNode struct{ depth int }
+
+func (n testNode) LogObject() []Field {
+	return []Field{ObjectOf("child", testNode{depth: n.depth + 1})}
+}
+
+func TestAny_Marshalers(t *testing.T) {
+	if f := Any("user", &testUser{Name: "alice"}); f.Type != ObjectMarshalerType {
+		t.Errorf("Any(ObjectMarshaler).Type = %v, want ObjectMarshalerType", f.Type)
+	}
+	if f := Any("tags", testTags{"a"}); f.Type != ArrayMarshalerType {
+		t.Errorf("Any(ArrayMarshaler).Type = %v, want ArrayMarshalerType", f.Type)
+	}
+}
+
+func TestResolveMarshalers(t *testing.T) {
+	boss := &testUser{Name: "bob"}
+	fs := []Field{
+		String("plain", "x"),
+		ObjectOf("user", &testUser{Name: "alice", Manager: boss}),
+		Object("nested", ArrayOf("tags", testTags{"a", "b"})),
+	}
+
+	got := ResolveMarshalers(fs)
+	want := []Field{
+		String("plain", "x"),
+		Object("user", String("name", "alice"), Object("manager", String("name", "bob"))),
+		Object("nested", Array("tags", String("", "a"), String("", "b"))),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResolveMarshalers() = %+v, want %+v", got, want)
+	}
+
+	plain := []Field{String("a", "b"), Object("o", Int("n", 1))}
+	if got := ResolveMarshalers(plain); &got[0] != &plain[0] {
+		t.Error("ResolveMarshalers() should return fields without marshalers unchanged")
+	}
+}
+
+func TestResolveMarshalers_Protection(t *testing.T) {
+	alice := &testUser{Name: "alice"}
+	bob := &testUser{Name: "bob", Manager: alice}
+	alice.Manager = bob
+
+	got := ResolveMarshalers([]Field{ObjectOf("user", alice)})
+	want := Object("user",
+		String("name", "alice"),
+		Object("manager", String("name", "bob"), String("manager", CycleValue)),
+	)
+	if !reflect.DeepEqual(got[0], want) {
+		t.Errorf("cycle = %+v, want %+v", got[0], want)
+	}
+
+	// The same marshaler twice side by side is not a cycle
+	got = ResolveMarshalers([]Field{Array("users", ObjectOf("", bob), ObjectOf("", bob))})
+	for _, elem := range got[0].Fields() {
+		if elem.Type != ObjectType {
+			t.Errorf("sibling marshaler = %+v, want object", elem)
+		}
+	}
+
+	got = ResolveMarshalers([]Field{ObjectOf("node", testNode{})})
+	depth := 0
+	for f := got[0]; f.Type == ObjectType; f = f.Fields()[0] {
+		depth++
+		if next := f.Fields()[0]; next.Type == StringType && next.String != DepthValue {
+			t.Errorf("deepest field = %+v, want %s", next, DepthValue)
+		}
+	}
+	if depth != maxMarshalDepth {
+		t.Errorf("depth = %d, want %d", depth, maxMarshalDepth)
+	}
+
+	var nilUser *testUser
+	if got := ResolveMarshalers([]Field{Any("user", nilUser)}); got[0].Value() != nil {
+		t.Errorf("nil marshaler = %+v, want null", got[0])
+	}
+}
+
+func TestField_ValueMarshalers(t *testing.T) {
+	got := ObjectOf("user", &testUser{Name: "alice"}).Value()
+	if want := map[string]interface{}{"name": "alice"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Value() = %v, want %v", got, want)
+	}
+
+	got = ArrayOf("tags", testTags{"a", "b"}).Value()
+	if want := []interface{}{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Value() = %v, want %v", got, want)
+	}
+}
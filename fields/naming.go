@@ -0,0 +1,143 @@
+package fields
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamingScheme selects the field names written by every engine and by OTLP export.
+type NamingScheme string
+
+const (
+	// DefaultScheme uses the snake_case names defined in this package.
+	DefaultScheme NamingScheme = "default"
+	// ECSScheme uses Elastic Common Schema names such as "@timestamp" and "log.level".
+	ECSScheme NamingScheme = "ecs"
+	// OTelScheme uses OpenTelemetry log data model and semantic convention names.
+	OTelScheme NamingScheme = "otel"
+	// GCPScheme uses the names recognized by Google Cloud Logging, such as "severity".
+	GCPScheme NamingScheme = "gcp"
+	// DatadogScheme uses Datadog reserved and standard attribute names.
+	DatadogScheme NamingScheme = "datadog"
+)
+
+// schemeNames holds, for each scheme, the output name of every standard
+// field that differs from its default name.
+var schemeNames = map[NamingScheme]map[string]string{
+	DefaultScheme: {},
+	ECSScheme: {
+		TimestampField:   "@timestamp",
+		LevelField:       "log.level",
+		CallerField:      "log.origin.file.name",
+		LoggerField:      "log.logger",
+		TraceIDField:     "trace.id",
+		SpanIDField:      "span.id",
+		ErrorField:       "error.message",
+		ErrorTypeField:   "error.type",
+		StacktraceField:  "error.stack_trace",
+		ServiceField:     "service.name",
+		ServiceVersion:   "service.version",
+		EnvironmentField: "service.environment",
+		RequestIDField:   "http.request.id",
+		UserIDField:      "user.id",
+		SessionIDField:   "session.id",
+		DurationField:    "event.duration",
+	},
+	OTelScheme: {
+		LevelField:       "severity_text",
+		MessageField:     "body",
+		CallerField:      "code.filepath",
+		LoggerField:      "otel.scope.name",
+		ErrorField:       "exception.message",
+		ErrorTypeField:   "exception.type",
+		StacktraceField:  "exception.stacktrace",
+		ServiceField:     "service.name",
+		ServiceVersion:   "service.version",
+		EnvironmentField: "deployment.environment",
+		UserIDField:      "enduser.id",
+		SessionIDField:   "session.id",
+	},
+	GCPScheme: {
+		TimestampField:  "time",
+		LevelField:      "severity",
+		TraceIDField:    "logging.googleapis.com/trace",
+		SpanIDField:     "logging.googleapis.com/spanId",
+		StacktraceField: "stack_trace",
+	},
+	DatadogScheme: {
+		LevelField:       "status",
+		LoggerField:      "logger.name",
+		TraceIDField:     "dd.trace_id",
+		SpanIDField:      "dd.span_id",
+		ErrorField:       "error.message",
+		ErrorTypeField:   "error.kind",
+		StacktraceField:  "error.stack",
+		ServiceVersion:   "version",
+		EnvironmentField: "env",
+		UserIDField:      "usr.id",
+	},
+}
+
+// ParseNamingScheme parses a scheme name case-insensitively; an empty name
+// selects DefaultScheme.
+func ParseNamingScheme(text string) (NamingScheme, error) {
+	name := NamingScheme(strings.ToLower(strings.TrimSpace(text)))
+	if name == "" {
+		return DefaultScheme, nil
+	}
+	if _, ok := schemeNames[name]; !ok {
+		return DefaultScheme, fmt.Errorf("unknown field naming scheme %q", text)
+	}
+	return name, nil
+}
+
+// NewFieldMapperWithScheme creates a field mapper that writes names from
+// scheme, with mapping overriding individual names. Mapping keys may be
+// standard names, their aliases (such as "traceId") or any custom field
+// name; values are the names to write. All lookups are precomputed.
+func NewFieldMapperWithScheme(scheme NamingScheme, mapping map[string]string) (*FieldMapper, error) {
+	names, ok := schemeNames[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unknown field naming scheme %q", scheme)
+	}
+
+	// Resolve the output name of each standard field, then apply overrides
+	output := make(map[string]string, len(standardFieldNames)+len(mapping))
+	for _, canonical := range standardFieldNames {
+		output[canonical] = canonical
+	}
+	for canonical, name := range names {
+		output[canonical] = name
+	}
+	for key, name := range mapping {
+		if name == "" {
+			return nil, fmt.Errorf("empty field name for %q", key)
+		}
+		if canonical, ok := standardNames[key]; ok {
+			key = canonical
+		}
+		output[key] = name
+	}
+
+	lookup := make(map[string]string, len(standardNames)+2*len(output))
+	for alias, canonical := range standardNames {
+		lookup[alias] = output[canonical]
+	}
+	for key, name := range output {
+		lookup[key] = name
+	}
+	// Output names map to themselves so standardizing twice is harmless
+	for _, name := range output {
+		lookup[name] = name
+	}
+
+	return &FieldMapper{scheme: scheme, names: lookup}, nil
+}
+
+// Scheme returns the naming scheme used by the mapper.
+func (fm *FieldMapper) Scheme() NamingScheme {
+	if fm == nil || fm.scheme == "" {
+		return DefaultScheme
+	}
+	return fm.scheme
+}
@@ -0,0 +1,128 @@
+package fields
+
+import "testing"
+
+func TestParseNamingScheme(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    NamingScheme
+		wantErr bool
+	}{
+		{"", DefaultScheme, false},
+		{"ECS", ECSScheme, false},
+		{" otel ", OTelScheme, false},
+		{"gcp", GCPScheme, false},
+		{"datadog", DatadogScheme, false},
+		{"logfmt", DefaultScheme, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseNamingScheme(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseNamingScheme(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseNamingScheme(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewFieldMapperWithScheme(t *testing.T) {
+	tests := []struct {
+		scheme NamingScheme
+		names  map[string]string
+	}{
+		{DefaultScheme, map[string]string{
+			"ts":       TimestampField,
+			"traceId":  TraceIDField,
+			ErrorField: ErrorField,
+			"user":     "user",
+		}},
+		{ECSScheme, map[string]string{
+			"ts":         "@timestamp",
+			LevelField:   "log.level",
+			"traceId":    "trace.id",
+			ErrorField:   "error.message",
+			ServiceField: "service.name",
+		}},
+		{OTelScheme, map[string]string{
+			"msg":           "body",
+			LevelField:      "severity_text",
+			StacktraceField: "exception.stacktrace",
+			TraceIDField:    TraceIDField,
+		}},
+		{GCPScheme, map[string]string{
+			TimestampField: "time",
+			LevelField:     "severity",
+			"trace.id":     "logging.googleapis.com/trace",
+		}},
+		{DatadogScheme, map[string]string{
+			LevelField:       "status",
+			"spanId":         "dd.span_id",
+			EnvironmentField: "env",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.scheme), func(t *testing.T) {
+			mapper, err := NewFieldMapperWithScheme(tt.scheme, nil)
+			if err != nil {
+				t.Fatalf("NewFieldMapperWithScheme() error = %v", err)
+			}
+			if mapper.Scheme() != tt.scheme {
+				t.Errorf("Scheme() = %q, want %q", mapper.Scheme(), tt.scheme)
+			}
+			for in, want := range tt.names {
+				got := mapper.StandardName(in)
+				if got != want {
+					t.Errorf("StandardName(%q) = %q, want %q", in, got, want)
+				}
+				// Output names are stable when standardized again
+				if again := mapper.StandardName(got); again != got {
+					t.Errorf("StandardName(%q) = %q, want it unchanged", got, again)
+				}
+			}
+		})
+	}
+}
+
+func TestNewFieldMapperWithScheme_Mapping(t *testing.T) {
+	mapper, err := NewFieldMapperWithScheme(ECSScheme, map[string]string{
+		"traceId": "traceparent.id",
+		"user":    "user.name",
+	})
+	if err != nil {
+		t.Fatalf("NewFieldMapperWithScheme() error = %v", err)
+	}
+
+	tests := map[string]string{
+		TraceIDField: "traceparent.id",
+		"trace.id":   "traceparent.id",
+		"user":       "user.name",
+		LevelField:   "log.level",
+	}
+	for in, want := range tests {
+		if got := mapper.StandardName(in); got != want {
+			t.Errorf("StandardName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNewFieldMapperWithScheme_Invalid(t *testing.T) {
+	if _, err := NewFieldMapperWithScheme("logfmt", nil); err == nil {
+		t.Error("Expected error for unknown scheme")
+	}
+	if _, err := NewFieldMapperWithScheme(DefaultScheme, map[string]string{"user": ""}); err == nil {
+		t.Error("Expected error for empty field name")
+	}
+}
+
+func TestFieldMapper_ZeroValue(t *testing.T) {
+	var mapper FieldMapper
+	if got := mapper.StandardName("traceId"); got != TraceIDField {
+		t.Errorf("StandardName() = %q, want %q", got, TraceIDField)
+	}
+	if mapper.Scheme() != DefaultScheme {
+		t.Errorf("Scheme() = %q, want %q", mapper.Scheme(), DefaultScheme)
+	}
+}
@@ -0,0 +1,100 @@
+package fields
+
+import (
+	"log/slog"
+)
+
+const (
+	// BadKeyField is the key given to arguments that appear where a key is
+	// expected but are not strings, and to a trailing key without a value.
+	BadKeyField = "!BADKEY"
+
+	// MalformedField reports how many arguments were recorded under BadKeyField.
+	MalformedField = "_malformed"
+)
+
+// Normalize converts loosely typed keysAndValues into typed fields. Each
+// element is either a string key followed by its value, a Field, or a
+// slog.Attr; Fields and Attrs stand alone and need no key. Anything else in
+// key position, and a trailing key without a value, is recorded under
+// BadKeyField, and a MalformedField count is appended so malformed calls
+// are visible rather than silently repaired.
+func Normalize(keysAndValues ...interface{}) []Field {
+	if len(keysAndValues) == 0 {
+		return nil
+	}
+
+	fs := make([]Field, 0, (len(keysAndValues)+1)/2)
+	malformed := 0
+	for i := 0; i < len(keysAndValues); i++ {
+		switch k := keysAndValues[i].(type) {
+		case Field:
+			fs = append(fs, k)
+		case slog.Attr:
+			fs = appendAttr(fs, k)
+		case string:
+			if i+1 >= len(keysAndValues) {
+				fs = append(fs, String(BadKeyField, k))
+				malformed++
+				continue
+			}
+			i++
+			fs = append(fs, Any(k, keysAndValues[i]))
+		default:
+			fs = append(fs, Any(BadKeyField, k))
+			malformed++
+		}
+	}
+
+	if malformed > 0 {
+		fs = append(fs, Int(MalformedField, malformed))
+	}
+	return fs
+}
+
+// FromAttr converts a slog.Attr to a Field, resolving slog.LogValuer values
+// and turning groups into objects.
+func FromAttr(a slog.Attr) Field {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return String(a.Key, v.String())
+	case slog.KindInt64:
+		return Int64(a.Key, v.Int64())
+	case slog.KindUint64:
+		return Uint64(a.Key, v.Uint64())
+	case slog.KindFloat64:
+		return Float64(a.Key, v.Float64())
+	case slog.KindBool:
+		return Bool(a.Key, v.Bool())
+	case slog.KindDuration:
+		return Duration(a.Key, v.Duration())
+	case slog.KindTime:
+		return Time(a.Key, v.Time())
+	case slog.KindGroup:
+		group := v.Group()
+		nested := make([]Field, 0, len(group))
+		for _, g := range group {
+			nested = appendAttr(nested, g)
+		}
+		return Object(a.Key, nested...)
+	default:
+		return Any(a.Key, v.Any())
+	}
+}
+
+// appendAttr appends a slog.Attr following slog's rules: empty attributes
+// are dropped and groups with an empty key are inlined.
+func appendAttr(fs []Field, a slog.Attr) []Field {
+	if a.Key == "" {
+		v := a.Value.Resolve()
+		if v.Kind() != slog.KindGroup {
+			return fs
+		}
+		for _, g := range v.Group() {
+			fs = appendAttr(fs, g)
+		}
+		return fs
+	}
+	return append(fs, FromAttr(a))
+}
@@ -0,0 +1,108 @@
+package fields
+
+import (
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []interface{}
+		want []Field
+	}{
+		{
+			name: "empty",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "pairs",
+			in:   []interface{}{"user", "alice", "count", 3},
+			want: []Field{String("user", "alice"), Int("count", 3)},
+		},
+		{
+			name: "dangling key",
+			in:   []interface{}{"user", "alice", "dangling"},
+			want: []Field{String("user", "alice"), String(BadKeyField, "dangling"), Int(MalformedField, 1)},
+		},
+		{
+			name: "non-string key",
+			in:   []interface{}{42, "user", "alice"},
+			want: []Field{Int(BadKeyField, 42), String("user", "alice"), Int(MalformedField, 1)},
+		},
+		{
+			name: "nil key",
+			in:   []interface{}{nil, "user", "alice"},
+			want: []Field{Any(BadKeyField, nil), String("user", "alice"), Int(MalformedField, 1)},
+		},
+		{
+			name: "typed fields stand alone",
+			in:   []interface{}{String("user", "alice"), "count", 3, Bool("ok", true)},
+			want: []Field{String("user", "alice"), Int("count", 3), Bool("ok", true)},
+		},
+		{
+			name: "slog attrs stand alone",
+			in:   []interface{}{slog.String("user", "alice"), "count", 3},
+			want: []Field{String("user", "alice"), Int("count", 3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+type userValuer struct{ name string }
+
+func (u userValuer) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("name", u.name))
+}
+
+func TestFromAttr(t *testing.T) {
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	boom := errors.New("boom")
+
+	tests := []struct {
+		name string
+		attr slog.Attr
+		want Field
+	}{
+		{"string", slog.String("k", "v"), String("k", "v")},
+		{"int64", slog.Int64("k", 7), Int64("k", 7)},
+		{"uint64", slog.Uint64("k", 7), Uint64("k", 7)},
+		{"float64", slog.Float64("k", 0.5), Float64("k", 0.5)},
+		{"bool", slog.Bool("k", true), Bool("k", true)},
+		{"duration", slog.Duration("k", time.Second), Duration("k", time.Second)},
+		{"time", slog.Time("k", at), Time("k", at)},
+		{"any error", slog.Any("k", boom), NamedErr("k", boom)},
+		{"group", slog.Group("k", slog.String("a", "b")), Object("k", String("a", "b"))},
+		{"log valuer", slog.Any("k", userValuer{name: "alice"}), Object("k", String("name", "alice"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromAttr(tt.attr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromAttr() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize_SlogAttrRules(t *testing.T) {
+	got := Normalize(
+		slog.Attr{},
+		slog.Group("", slog.String("a", "1"), slog.String("b", "2")),
+	)
+	want := []Field{String("a", "1"), String("b", "2")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Normalize() = %+v, want %+v", got, want)
+	}
+}
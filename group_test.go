@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kart-io/logger/option"
+)
+
+func TestWithGroup_ConsoleFormat(t *testing.T) {
+	tests := []struct {
+		engine string
+		want   []string
+	}{
+		{"slog", []string{"service=billing", "request.method=GET", "request.client.ip=10.0.0.1", "group_test.go:"}},
+		{"zap", []string{`"service": "billing"`, `"request.method": "GET"`, `"request.client.ip": "10.0.0.1"`, "group_test.go:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.engine, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = tt.engine
+			opt.Format = "console"
+			opt.OutputPaths = []string{logFile}
+
+			l, err := New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+			l.With("service", "billing").WithGroup("request").With("method", "GET").
+				WithGroup("client").Infow("grouped", "ip", "10.0.0.1")
+
+			data, err := os.ReadFile(logFile)
+			if err != nil {
+				t.Fatalf("Failed to read log file: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("Expected %q in output: %s", want, data)
+				}
+			}
+		})
+	}
+}
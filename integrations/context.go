@@ -0,0 +1,116 @@
+package integrations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/kart-io/logger/core"
+)
+
+// RequestIDHeader is the default header used to propagate request IDs
+const RequestIDHeader = "X-Request-ID"
+
+type loggerContextKey struct{}
+
+type requestIDContextKey struct{}
+
+type traceIDContextKey struct{}
+
+// ContextWithLogger returns a copy of ctx carrying a request-scoped logger
+func ContextWithLogger(ctx context.Context, logger core.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// LoggerFromContext returns the request-scoped logger stored in ctx, if any
+func LoggerFromContext(ctx context.Context) (core.Logger, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	logger, ok := ctx.Value(loggerContextKey{}).(core.Logger)
+	return logger, ok
+}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, or ""
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// ContextWithTraceID returns a copy of ctx carrying the trace ID
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID stored in ctx, or ""
+func TraceIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	traceID, _ := ctx.Value(traceIDContextKey{}).(string)
+	return traceID
+}
+
+// NewRequestID generates a random 128-bit request ID in hex form
+func NewRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// ClientIP returns the originating client address of an HTTP request,
+// preferring X-Forwarded-For and X-Real-IP over the connection address.
+func ClientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		if ip := strings.TrimSpace(strings.Split(forwarded, ",")[0]); ip != "" {
+			return ip
+		}
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" {
+		return realIP
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// LevelForStatus returns the default log level for an HTTP status code:
+// error for 5xx, warn for 4xx and info otherwise.
+func LevelForStatus(statusCode int) core.Level {
+	switch {
+	case statusCode >= 500:
+		return core.ErrorLevel
+	case statusCode >= 400:
+		return core.WarnLevel
+	default:
+		return core.InfoLevel
+	}
+}
+
+// LogAtLevel logs a structured message at the given level
+func LogAtLevel(logger core.Logger, level core.Level, msg string, keysAndValues ...interface{}) {
+	switch level {
+	case core.DebugLevel:
+		logger.Debugw(msg, keysAndValues...)
+	case core.WarnLevel:
+		logger.Warnw(msg, keysAndValues...)
+	case core.ErrorLevel, core.FatalLevel:
+		logger.Errorw(msg, keysAndValues...)
+	default:
+		logger.Infow(msg, keysAndValues...)
+	}
+}
@@ -0,0 +1,69 @@
+package integrations
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/errors"
+)
+
+func TestContextHelpers(t *testing.T) {
+	if _, ok := LoggerFromContext(context.Background()); ok {
+		t.Error("Expected no logger in empty context")
+	}
+
+	logger := errors.NewNoOpLogger()
+	ctx := ContextWithRequestID(ContextWithLogger(context.Background(), logger), "req-1")
+
+	if got, ok := LoggerFromContext(ctx); !ok || got != logger {
+		t.Error("Expected logger to round-trip through context")
+	}
+	if got := RequestIDFromContext(ctx); got != "req-1" {
+		t.Errorf("Expected request ID req-1, got %q", got)
+	}
+	if got := TraceIDFromContext(ContextWithTraceID(ctx, "trace-1")); got != "trace-1" {
+		t.Errorf("Expected trace ID trace-1, got %q", got)
+	}
+}
+
+func TestNewRequestID(t *testing.T) {
+	a, b := NewRequestID(), NewRequestID()
+	if len(a) != 32 || a == b {
+		t.Errorf("Expected unique 32 character IDs, got %q and %q", a, b)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected string
+	}{
+		{"remote addr", nil, "192.0.2.1"},
+		{"forwarded for", map[string]string{"X-Forwarded-For": "203.0.113.7, 10.0.0.1"}, "203.0.113.7"},
+		{"real ip", map[string]string{"X-Real-IP": "198.51.100.2"}, "198.51.100.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			if got := ClientIP(req); got != tt.expected {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLevelForStatus(t *testing.T) {
+	tests := map[int]core.Level{200: core.InfoLevel, 302: core.InfoLevel, 404: core.WarnLevel, 503: core.ErrorLevel}
+	for status, want := range tests {
+		if got := LevelForStatus(status); got != want {
+			t.Errorf("LevelForStatus(%d) = %s, want %s", status, got, want)
+		}
+	}
+}
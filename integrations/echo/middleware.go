@@ -0,0 +1,305 @@
+package echo
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// loggerKey is the echo.Context key under which the request-scoped logger is stored
+const loggerKey = "kart-io/logger"
+
+// Config holds configuration for the Echo middleware
+type Config struct {
+	// SkipPaths are request paths that are served without being logged.
+	// Entries ending in "*" match any path with that prefix.
+	SkipPaths []string
+
+	// RequestIDHeader is the header read and written for request IDs (default: X-Request-ID)
+	RequestIDHeader string
+
+	// GenerateRequestID creates a request ID when the request carries none
+	GenerateRequestID func() string
+
+	// StatusLevels overrides the log level for specific status codes.
+	// Codes without an entry use error for 5xx, warn for 4xx and info otherwise.
+	StatusLevels map[int]core.Level
+
+	// SlowThreshold logs requests slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// MaxBodyBytes captures up to this many bytes of the request and response
+	// bodies (0 disables body capture)
+	MaxBodyBytes int
+
+	// HeaderAllowlist lists request headers included in the access log
+	HeaderAllowlist []string
+}
+
+// DefaultConfig returns default configuration for the Echo middleware
+func DefaultConfig() Config {
+	return Config{
+		RequestIDHeader:   integrations.RequestIDHeader,
+		GenerateRequestID: integrations.NewRequestID,
+		SlowThreshold:     5 * time.Second,
+	}
+}
+
+// EchoAdapter implements integrations.HTTPAdapter for the Echo framework
+type EchoAdapter struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// NewEchoAdapter creates a new Echo adapter
+func NewEchoAdapter(coreLogger core.Logger) *EchoAdapter {
+	return NewEchoAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewEchoAdapterWithConfig creates a new Echo adapter with configuration
+func NewEchoAdapterWithConfig(coreLogger core.Logger, config Config) *EchoAdapter {
+	if config.RequestIDHeader == "" {
+		config.RequestIDHeader = integrations.RequestIDHeader
+	}
+	if config.GenerateRequestID == nil {
+		config.GenerateRequestID = integrations.NewRequestID
+	}
+
+	return &EchoAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "Echo", "v4.x"),
+		config:      config,
+	}
+}
+
+// FromContext returns the request-scoped logger attached by the middleware,
+// or the global logger when none is attached
+func FromContext(c echo.Context) core.Logger {
+	if l, ok := loggerFrom(c); ok {
+		return l
+	}
+	return logger.Global()
+}
+
+func loggerFrom(c echo.Context) (core.Logger, bool) {
+	if l, ok := c.Get(loggerKey).(core.Logger); ok {
+		return l, true
+	}
+	return integrations.LoggerFromContext(c.Request().Context())
+}
+
+// Middleware returns an Echo middleware that logs every request, propagates
+// request IDs and attaches a request-scoped logger to the context
+func (e *EchoAdapter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if integrations.MatchPath(req.URL.Path, e.config.SkipPaths) {
+				return next(c)
+			}
+
+			start := time.Now()
+
+			requestID := req.Header.Get(e.config.RequestIDHeader)
+			if requestID == "" {
+				requestID = e.config.GenerateRequestID()
+			}
+			c.Response().Header().Set(e.config.RequestIDHeader, requestID)
+
+			reqLogger := e.GetLogger().With(fields.RequestIDField, requestID)
+			c.Set(loggerKey, reqLogger)
+			ctx := integrations.ContextWithRequestID(req.Context(), requestID)
+			req = req.WithContext(integrations.ContextWithLogger(ctx, reqLogger))
+			c.SetRequest(req)
+
+			requestBody := integrations.CaptureRequestBody(req, e.config.MaxBodyBytes)
+			var responseBody *integrations.BodyBuffer
+			if e.config.MaxBodyBytes > 0 {
+				responseBody = &integrations.BodyBuffer{Limit: e.config.MaxBodyBytes}
+				res := c.Response()
+				res.Writer = &bodyCaptureWriter{ResponseWriter: res.Writer, body: responseBody}
+			}
+
+			err := next(c)
+			if err != nil {
+				// Let Echo's error handler write the response so the logged status is accurate
+				c.Error(err)
+			}
+
+			latency := time.Since(start)
+			res := c.Response()
+			route := c.Path()
+			if route == "" {
+				route = req.URL.Path
+			}
+
+			logFields := []interface{}{
+				"component", "echo",
+				"method", req.Method,
+				"route", route,
+				"path", req.URL.Path,
+				"status_code", res.Status,
+				"bytes", res.Size,
+				"latency_ms", float64(latency.Nanoseconds()) / 1e6,
+				"client_ip", c.RealIP(),
+				"user_agent", req.UserAgent(),
+			}
+
+			if userID := c.Get("user_id"); userID != nil {
+				logFields = append(logFields, fields.UserIDField, userID)
+			}
+			if headers := integrations.CaptureHeaders(req.Header, e.config.HeaderAllowlist); headers != nil {
+				logFields = append(logFields, "headers", headers)
+			}
+			if requestBody != nil {
+				logFields = append(logFields, "request_body", requestBody.String())
+				if requestBody.Truncated {
+					logFields = append(logFields, "request_body_truncated", true)
+				}
+			}
+			if responseBody != nil {
+				logFields = append(logFields, "response_body", responseBody.String())
+				if responseBody.Truncated {
+					logFields = append(logFields, "response_body_truncated", true)
+				}
+			}
+			if err != nil {
+				logFields = append(logFields, fields.ErrorField, err.Error())
+			}
+
+			level := e.levelForStatus(res.Status)
+			if e.config.SlowThreshold > 0 && latency > e.config.SlowThreshold {
+				logFields = append(logFields, "slow_request", true)
+				if level < core.WarnLevel {
+					level = core.WarnLevel
+				}
+			}
+
+			integrations.LogAtLevel(reqLogger, level, req.Method+" "+route, logFields...)
+			return nil
+		}
+	}
+}
+
+// Recovery returns an Echo middleware that recovers panics, logs them with a
+// stacktrace and responds with 500
+func (e *EchoAdapter) Recovery() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					// http.ErrAbortHandler deliberately aborts the response
+					// and is left for the server to handle
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+					reqLogger, ok := loggerFrom(c)
+					if !ok {
+						reqLogger = e.GetLogger()
+					}
+					reqLogger.Errorw("Panic recovered",
+						"component", "echo",
+						"method", c.Request().Method,
+						"path", c.Request().URL.Path,
+						"route", c.Path(),
+						"client_ip", c.RealIP(),
+						"error", fmt.Sprint(rec),
+						"panic", true,
+						"panic_stack", string(debug.Stack()),
+					)
+
+					err = c.JSON(http.StatusInternalServerError, map[string]interface{}{
+						"error": "Internal server error",
+						"code":  "PANIC_RECOVERED",
+					})
+				}
+			}()
+			return next(c)
+		}
+	}
+}
+
+// LogRequest logs an HTTP request (implements HTTPAdapter interface)
+func (e *EchoAdapter) LogRequest(method, path string, statusCode int, duration int64, userID string) {
+	logFields := []interface{}{
+		"component", "echo",
+		"operation", "http_request",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"duration_ms", float64(duration) / 1e6,
+	}
+
+	if userID != "" {
+		logFields = append(logFields, "user_id", userID)
+	}
+
+	integrations.LogAtLevel(e.GetLogger(), e.levelForStatus(statusCode), fmt.Sprintf("HTTP %s %s", method, path), logFields...)
+}
+
+// LogMiddleware logs middleware execution (implements HTTPAdapter interface)
+func (e *EchoAdapter) LogMiddleware(middlewareName string, duration int64) {
+	e.GetLogger().Debugw("Middleware executed",
+		"component", "echo",
+		"operation", "middleware",
+		"middleware_name", middlewareName,
+		"duration_ms", float64(duration)/1e6,
+	)
+}
+
+// LogError logs HTTP-related errors (implements HTTPAdapter interface)
+func (e *EchoAdapter) LogError(err error, method, path string, statusCode int) {
+	e.GetLogger().Errorw("HTTP request failed",
+		"component", "echo",
+		"operation", "http_error",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+}
+
+func (e *EchoAdapter) levelForStatus(statusCode int) core.Level {
+	if level, ok := e.config.StatusLevels[statusCode]; ok {
+		return level
+	}
+	return integrations.LevelForStatus(statusCode)
+}
+
+// bodyCaptureWriter copies the response body into a bounded buffer
+type bodyCaptureWriter struct {
+	http.ResponseWriter
+	body *integrations.BodyBuffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher when the underlying writer supports it
+func (w *bodyCaptureWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker when the underlying writer supports it,
+// so protocol upgrades such as websockets work while bodies are captured
+func (w *bodyCaptureWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *bodyCaptureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
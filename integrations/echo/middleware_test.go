@@ -0,0 +1,234 @@
+package echo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+func newTestEcho(adapter *EchoAdapter) *echo.Echo {
+	e := echo.New()
+	e.Use(adapter.Middleware(), adapter.Recovery())
+	return e
+}
+
+func TestEchoAdapter_ImplementsInterface(t *testing.T) {
+	adapter := NewEchoAdapter(logtest.New())
+	var _ integrations.HTTPAdapter = adapter
+	if adapter.Name() != "Echo" {
+		t.Errorf("Expected name Echo, got %s", adapter.Name())
+	}
+}
+
+func TestMiddleware_LogsRequest(t *testing.T) {
+	logger := logtest.New()
+	e := newTestEcho(NewEchoAdapter(logger))
+	e.GET("/users/:id", func(c echo.Context) error {
+		FromContext(c).Infow("handling user")
+		c.Set("user_id", "u-1")
+		return c.String(http.StatusOK, "hello")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	requestID := rec.Header().Get("X-Request-ID")
+	if requestID == "" {
+		t.Fatal("Expected generated request ID in response header")
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].Fields["request_id"] != requestID {
+		t.Errorf("Expected handler logger to carry request ID, got %v", entries[0].Fields)
+	}
+
+	access := entries[1]
+	expected := map[string]interface{}{
+		"component":   "echo",
+		"method":      "GET",
+		"route":       "/users/:id",
+		"path":        "/users/42",
+		"status_code": http.StatusOK,
+		"bytes":       int64(5),
+		"user_agent":  "test-agent",
+		"user_id":     "u-1",
+		"request_id":  requestID,
+	}
+	for key, want := range expected {
+		if got := access.Fields[key]; got != want {
+			t.Errorf("Field %s = %v (%T), want %v", key, got, got, want)
+		}
+	}
+}
+
+func TestMiddleware_BodyAndHeaderCapture(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.MaxBodyBytes = 8
+	config.HeaderAllowlist = []string{"X-Tenant"}
+
+	e := newTestEcho(NewEchoAdapterWithConfig(logger, config))
+	var received string
+	e.POST("/echo", func(c echo.Context) error {
+		body, _ := io.ReadAll(c.Request().Body)
+		received = string(body)
+		return c.String(http.StatusOK, "short")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("0123456789abcdef"))
+	req.Header.Set("X-Tenant", "acme")
+	req.Header.Set("Authorization", "Bearer secret")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received != "0123456789abcdef" {
+		t.Errorf("Expected handler to receive the full body, got %q", received)
+	}
+
+	access := logger.Entries()[0]
+	if access.Fields["request_body"] != "01234567" || access.Fields["request_body_truncated"] != true {
+		t.Errorf("Expected truncated request body, got %v", access.Fields["request_body"])
+	}
+	if access.Fields["response_body"] != "short" {
+		t.Errorf("Expected response body, got %v", access.Fields["response_body"])
+	}
+	headers, _ := access.Fields["headers"].(map[string]string)
+	if len(headers) != 1 || headers["X-Tenant"] != "acme" {
+		t.Errorf("Expected only allowlisted headers, got %v", headers)
+	}
+}
+
+func TestMiddleware_HandlerErrorAndStatusLevels(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.SkipPaths = []string{"/healthz"}
+	config.StatusLevels = map[int]core.Level{http.StatusNotFound: core.DebugLevel}
+
+	e := newTestEcho(NewEchoAdapterWithConfig(logger, config))
+	e.GET("/healthz", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET("/forbidden", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusForbidden, "no access")
+	})
+
+	for _, path := range []string{"/healthz", "/missing", "/forbidden"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected skipped path not to be logged, got %d entries", len(entries))
+	}
+	if entries[0].Level != core.DebugLevel || entries[0].Fields["status_code"] != http.StatusNotFound {
+		t.Errorf("Expected 404 at configured debug level, got %+v", entries[0])
+	}
+	if entries[1].Level != core.WarnLevel || entries[1].Fields["status_code"] != http.StatusForbidden || entries[1].Fields["error"] == nil {
+		t.Errorf("Expected 403 at warn level with error, got %+v", entries[1])
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	logger := logtest.New()
+	e := newTestEcho(NewEchoAdapter(logger))
+	e.GET("/panic", func(c echo.Context) error { panic("boom") })
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", rec.Code)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected panic and access log entries, got %d", len(entries))
+	}
+	if stack, _ := entries[0].Fields["panic_stack"].(string); entries[0].Fields["error"] != "boom" || !strings.Contains(stack, "panic") || entries[0].Fields["request_id"] == nil {
+		t.Errorf("Expected request-scoped panic entry with panic_stack, got %v", entries[0].Fields)
+	}
+	if _, ok := entries[0].Fields["stacktrace"]; ok {
+		t.Error("Expected the panic stack not to use the engine's stacktrace key")
+	}
+	if entries[1].Level != core.ErrorLevel {
+		t.Errorf("Expected access log at error level, got %s", entries[1].Level)
+	}
+}
+
+func TestRecovery_RepanicsAbortHandler(t *testing.T) {
+	logger := logtest.New()
+	e := newTestEcho(NewEchoAdapter(logger))
+	e.GET("/abort", func(c echo.Context) error { panic(http.ErrAbortHandler) })
+
+	defer func() {
+		if rec := recover(); rec != http.ErrAbortHandler {
+			t.Errorf("Expected http.ErrAbortHandler to propagate, got %v", rec)
+		}
+		if entries := logger.Entries(); len(entries) != 0 {
+			t.Errorf("Expected an aborted handler not to be logged as a panic, got %+v", entries)
+		}
+	}()
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/abort", nil))
+}
+
+func TestMiddleware_HijackWithBodyCapture(t *testing.T) {
+	config := DefaultConfig()
+	config.MaxBodyBytes = 8
+	e := newTestEcho(NewEchoAdapterWithConfig(logtest.New(), config))
+	e.GET("/ws", func(c echo.Context) error {
+		if _, ok := c.Response().Writer.(http.Hijacker); !ok {
+			t.Error("Expected the body-capture writer to implement http.Hijacker")
+		}
+		conn, buf, err := c.Response().Hijack()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: test\r\n\r\n")
+		return buf.Flush()
+	})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/ws", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "test")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("Expected 101, got %d", resp.StatusCode)
+	}
+}
+
+func TestFromContext_RequestContext(t *testing.T) {
+	logger := logtest.New()
+	e := newTestEcho(NewEchoAdapter(logger))
+	e.GET("/ctx", func(c echo.Context) error {
+		l, ok := integrations.LoggerFromContext(c.Request().Context())
+		if !ok {
+			t.Error("Expected logger in request context")
+			return nil
+		}
+		l.Infow("from request context")
+		return nil
+	})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ctx", nil))
+
+	if entries := logger.Entries(); len(entries) != 2 || entries[0].Fields["request_id"] == nil {
+		t.Errorf("Expected request-scoped log entry, got %+v", entries)
+	}
+}
@@ -0,0 +1,279 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// loggerKey is the gin.Context key under which the request-scoped logger is stored
+const loggerKey = "kart-io/logger"
+
+// Config holds configuration for the Gin middleware
+type Config struct {
+	// SkipPaths are request paths that are served without being logged.
+	// Entries ending in "*" match any path with that prefix.
+	SkipPaths []string
+
+	// RequestIDHeader is the header read and written for request IDs (default: X-Request-ID)
+	RequestIDHeader string
+
+	// GenerateRequestID creates a request ID when the request carries none
+	GenerateRequestID func() string
+
+	// StatusLevels overrides the log level for specific status codes.
+	// Codes without an entry use error for 5xx, warn for 4xx and info otherwise.
+	StatusLevels map[int]core.Level
+
+	// SlowThreshold logs requests slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// MaxBodyBytes captures up to this many bytes of the request and response
+	// bodies (0 disables body capture)
+	MaxBodyBytes int
+
+	// HeaderAllowlist lists request headers included in the access log
+	HeaderAllowlist []string
+}
+
+// DefaultConfig returns default configuration for the Gin middleware
+func DefaultConfig() Config {
+	return Config{
+		RequestIDHeader:   integrations.RequestIDHeader,
+		GenerateRequestID: integrations.NewRequestID,
+		SlowThreshold:     5 * time.Second,
+	}
+}
+
+// GinAdapter implements integrations.HTTPAdapter for the Gin framework
+type GinAdapter struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// NewGinAdapter creates a new Gin adapter
+func NewGinAdapter(coreLogger core.Logger) *GinAdapter {
+	return NewGinAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewGinAdapterWithConfig creates a new Gin adapter with configuration
+func NewGinAdapterWithConfig(coreLogger core.Logger, config Config) *GinAdapter {
+	if config.RequestIDHeader == "" {
+		config.RequestIDHeader = integrations.RequestIDHeader
+	}
+	if config.GenerateRequestID == nil {
+		config.GenerateRequestID = integrations.NewRequestID
+	}
+
+	return &GinAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "Gin", "v1.x"),
+		config:      config,
+	}
+}
+
+// FromContext returns the request-scoped logger attached by the middleware,
+// or the global logger when none is attached
+func FromContext(c *gin.Context) core.Logger {
+	if l, ok := loggerFrom(c); ok {
+		return l
+	}
+	return logger.Global()
+}
+
+func loggerFrom(c *gin.Context) (core.Logger, bool) {
+	if value, ok := c.Get(loggerKey); ok {
+		if l, ok := value.(core.Logger); ok {
+			return l, true
+		}
+	}
+	return integrations.LoggerFromContext(c.Request.Context())
+}
+
+// Middleware returns a Gin middleware that logs every request, propagates
+// request IDs and attaches a request-scoped logger to the context
+func (g *GinAdapter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if integrations.MatchPath(c.Request.URL.Path, g.config.SkipPaths) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+
+		requestID := c.GetHeader(g.config.RequestIDHeader)
+		if requestID == "" {
+			requestID = g.config.GenerateRequestID()
+		}
+		c.Header(g.config.RequestIDHeader, requestID)
+
+		reqLogger := g.GetLogger().With(fields.RequestIDField, requestID)
+		c.Set(loggerKey, reqLogger)
+		ctx := integrations.ContextWithRequestID(c.Request.Context(), requestID)
+		c.Request = c.Request.WithContext(integrations.ContextWithLogger(ctx, reqLogger))
+
+		requestBody := integrations.CaptureRequestBody(c.Request, g.config.MaxBodyBytes)
+		var responseBody *integrations.BodyBuffer
+		if g.config.MaxBodyBytes > 0 {
+			responseBody = &integrations.BodyBuffer{Limit: g.config.MaxBodyBytes}
+			c.Writer = &bodyCaptureWriter{ResponseWriter: c.Writer, body: responseBody}
+		}
+
+		c.Next()
+
+		latency := time.Since(start)
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+
+		logFields := []interface{}{
+			"component", "gin",
+			"method", c.Request.Method,
+			"route", route,
+			"path", c.Request.URL.Path,
+			"status_code", c.Writer.Status(),
+			"bytes", c.Writer.Size(),
+			"latency_ms", float64(latency.Nanoseconds()) / 1e6,
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
+		}
+
+		if userID, exists := c.Get("user_id"); exists {
+			logFields = append(logFields, fields.UserIDField, userID)
+		}
+		if headers := integrations.CaptureHeaders(c.Request.Header, g.config.HeaderAllowlist); headers != nil {
+			logFields = append(logFields, "headers", headers)
+		}
+		if requestBody != nil {
+			logFields = append(logFields, "request_body", requestBody.String())
+			if requestBody.Truncated {
+				logFields = append(logFields, "request_body_truncated", true)
+			}
+		}
+		if responseBody != nil {
+			logFields = append(logFields, "response_body", responseBody.String())
+			if responseBody.Truncated {
+				logFields = append(logFields, "response_body_truncated", true)
+			}
+		}
+		if errs := c.Errors.ByType(gin.ErrorTypeAny); len(errs) > 0 {
+			logFields = append(logFields, "errors", errs.String())
+		}
+
+		level := g.levelForStatus(c.Writer.Status())
+		if g.config.SlowThreshold > 0 && latency > g.config.SlowThreshold {
+			logFields = append(logFields, "slow_request", true)
+			if level < core.WarnLevel {
+				level = core.WarnLevel
+			}
+		}
+
+		integrations.LogAtLevel(reqLogger, level, c.Request.Method+" "+route, logFields...)
+	}
+}
+
+// Recovery returns a Gin middleware that recovers panics, logs them with a
+// stacktrace and responds with 500
+func (g *GinAdapter) Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				// http.ErrAbortHandler deliberately aborts the response
+				// and is left for the server to handle
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+				reqLogger, ok := loggerFrom(c)
+				if !ok {
+					reqLogger = g.GetLogger()
+				}
+				reqLogger.Errorw("Panic recovered",
+					"component", "gin",
+					"method", c.Request.Method,
+					"path", c.Request.URL.Path,
+					"client_ip", c.ClientIP(),
+					"error", fmt.Sprint(rec),
+					"panic", true,
+					"panic_stack", string(debug.Stack()),
+				)
+
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": "Internal server error",
+					"code":  "PANIC_RECOVERED",
+				})
+			}
+		}()
+		c.Next()
+	}
+}
+
+// LogRequest logs an HTTP request (implements HTTPAdapter interface)
+func (g *GinAdapter) LogRequest(method, path string, statusCode int, duration int64, userID string) {
+	logFields := []interface{}{
+		"component", "gin",
+		"operation", "http_request",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"duration_ms", float64(duration) / 1e6,
+	}
+
+	if userID != "" {
+		logFields = append(logFields, "user_id", userID)
+	}
+
+	integrations.LogAtLevel(g.GetLogger(), g.levelForStatus(statusCode), fmt.Sprintf("HTTP %s %s", method, path), logFields...)
+}
+
+// LogMiddleware logs middleware execution (implements HTTPAdapter interface)
+func (g *GinAdapter) LogMiddleware(middlewareName string, duration int64) {
+	g.GetLogger().Debugw("Middleware executed",
+		"component", "gin",
+		"operation", "middleware",
+		"middleware_name", middlewareName,
+		"duration_ms", float64(duration)/1e6,
+	)
+}
+
+// LogError logs HTTP-related errors (implements HTTPAdapter interface)
+func (g *GinAdapter) LogError(err error, method, path string, statusCode int) {
+	g.GetLogger().Errorw("HTTP request failed",
+		"component", "gin",
+		"operation", "http_error",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+}
+
+func (g *GinAdapter) levelForStatus(statusCode int) core.Level {
+	if level, ok := g.config.StatusLevels[statusCode]; ok {
+		return level
+	}
+	return integrations.LevelForStatus(statusCode)
+}
+
+// bodyCaptureWriter copies the response body into a bounded buffer
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body *integrations.BodyBuffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.Write([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,213 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func newTestEngine(adapter *GinAdapter) *gin.Engine {
+	r := gin.New()
+	r.Use(adapter.Middleware(), adapter.Recovery())
+	return r
+}
+
+func TestGinAdapter_ImplementsInterface(t *testing.T) {
+	adapter := NewGinAdapter(logtest.New())
+	var _ integrations.HTTPAdapter = adapter
+	if adapter.Name() != "Gin" {
+		t.Errorf("Expected name Gin, got %s", adapter.Name())
+	}
+}
+
+func TestMiddleware_LogsRequest(t *testing.T) {
+	logger := logtest.New()
+	r := newTestEngine(NewGinAdapter(logger))
+	r.GET("/users/:id", func(c *gin.Context) {
+		FromContext(c).Infow("handling user")
+		c.Set("user_id", "u-1")
+		c.String(http.StatusOK, "hello")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("X-Request-ID", "req-42")
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Header().Get("X-Request-ID") != "req-42" {
+		t.Errorf("Expected request ID to be echoed, got %q", rec.Header().Get("X-Request-ID"))
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].Fields["request_id"] != "req-42" {
+		t.Errorf("Expected handler logger to carry request ID, got %v", entries[0].Fields)
+	}
+
+	access := entries[1]
+	expected := map[string]interface{}{
+		"component":   "gin",
+		"method":      "GET",
+		"route":       "/users/:id",
+		"path":        "/users/42",
+		"status_code": http.StatusOK,
+		"bytes":       5,
+		"user_agent":  "test-agent",
+		"user_id":     "u-1",
+		"request_id":  "req-42",
+	}
+	for key, want := range expected {
+		if got := access.Fields[key]; got != want {
+			t.Errorf("Field %s = %v, want %v", key, got, want)
+		}
+	}
+	if _, ok := access.Fields["request_body"]; ok {
+		t.Error("Expected no body capture by default")
+	}
+}
+
+func TestMiddleware_BodyAndHeaderCapture(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.MaxBodyBytes = 8
+	config.HeaderAllowlist = []string{"content-type", "X-Tenant"}
+
+	r := newTestEngine(NewGinAdapterWithConfig(logger, config))
+	var received string
+	r.POST("/echo", func(c *gin.Context) {
+		body, _ := c.GetRawData()
+		received = string(body)
+		c.String(http.StatusOK, "short")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("0123456789abcdef"))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Authorization", "Bearer secret")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received != "0123456789abcdef" {
+		t.Errorf("Expected handler to receive the full body, got %q", received)
+	}
+
+	access := logger.Entries()[0]
+	if access.Fields["request_body"] != "01234567" || access.Fields["request_body_truncated"] != true {
+		t.Errorf("Expected truncated request body, got %v / %v", access.Fields["request_body"], access.Fields["request_body_truncated"])
+	}
+	if access.Fields["response_body"] != "short" {
+		t.Errorf("Expected response body, got %v", access.Fields["response_body"])
+	}
+
+	headers, _ := access.Fields["headers"].(map[string]string)
+	if headers["Content-Type"] != "text/plain" {
+		t.Errorf("Expected allowlisted header, got %v", headers)
+	}
+	if _, ok := headers["Authorization"]; ok {
+		t.Error("Expected non-allowlisted header to be omitted")
+	}
+}
+
+func TestMiddleware_SkipPathsAndStatusLevels(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.SkipPaths = []string{"/healthz"}
+	config.StatusLevels = map[int]core.Level{http.StatusNotFound: core.DebugLevel}
+
+	r := newTestEngine(NewGinAdapterWithConfig(logger, config))
+	r.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/fail", func(c *gin.Context) {
+		c.Error(errors.New("database unavailable"))
+		c.Status(http.StatusServiceUnavailable)
+	})
+
+	for _, path := range []string{"/healthz", "/missing", "/fail"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected skipped path not to be logged, got %d entries", len(entries))
+	}
+	if entries[0].Level != core.DebugLevel || entries[0].Fields["status_code"] != http.StatusNotFound {
+		t.Errorf("Expected 404 at configured debug level, got %+v", entries[0])
+	}
+	if entries[1].Level != core.ErrorLevel || !strings.Contains(entries[1].Fields["errors"].(string), "database unavailable") {
+		t.Errorf("Expected 503 at error level with gin errors, got %+v", entries[1])
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	logger := logtest.New()
+	r := newTestEngine(NewGinAdapter(logger))
+	r.GET("/panic", func(c *gin.Context) { panic("boom") })
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", rec.Code)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected panic and access log entries, got %d", len(entries))
+	}
+	if stack, _ := entries[0].Fields["panic_stack"].(string); entries[0].Fields["error"] != "boom" || !strings.Contains(stack, "panic") || entries[0].Fields["request_id"] == nil {
+		t.Errorf("Expected request-scoped panic entry with panic_stack, got %v", entries[0].Fields)
+	}
+	if _, ok := entries[0].Fields["stacktrace"]; ok {
+		t.Error("Expected the panic stack not to use the engine's stacktrace key")
+	}
+	if entries[1].Level != core.ErrorLevel {
+		t.Errorf("Expected access log at error level, got %s", entries[1].Level)
+	}
+}
+
+func TestRecovery_RepanicsAbortHandler(t *testing.T) {
+	logger := logtest.New()
+	r := newTestEngine(NewGinAdapter(logger))
+	r.GET("/abort", func(c *gin.Context) { panic(http.ErrAbortHandler) })
+
+	defer func() {
+		if rec := recover(); rec != http.ErrAbortHandler {
+			t.Errorf("Expected http.ErrAbortHandler to propagate, got %v", rec)
+		}
+		if entries := logger.Entries(); len(entries) != 0 {
+			t.Errorf("Expected an aborted handler not to be logged as a panic, got %+v", entries)
+		}
+	}()
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/abort", nil))
+}
+
+func TestFromContext_RequestContext(t *testing.T) {
+	logger := logtest.New()
+	r := newTestEngine(NewGinAdapter(logger))
+	r.GET("/ctx", func(c *gin.Context) {
+		l, ok := integrations.LoggerFromContext(c.Request.Context())
+		if !ok {
+			t.Error("Expected logger in request context")
+			return
+		}
+		l.Infow("from request context")
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ctx", nil))
+
+	if entries := logger.Entries(); len(entries) != 2 || entries[0].Fields["request_id"] == nil {
+		t.Errorf("Expected request-scoped log entry, got %+v", entries)
+	}
+}
@@ -2,6 +2,7 @@ package gorm
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/kart-io/logger/core"
@@ -190,11 +191,16 @@ func (g *GormAdapter) GetIgnoreRecordNotFoundError() bool {
 	return g.ignoreRecordNotFoundError
 }
 
-// Helper function to check if an error is a RecordNotFound error
+// Helper function to check if an error is a RecordNotFound error.
+// Without importing GORM the error can only be matched by message, so the
+// whole wrap chain is checked; the gormlogger module uses errors.Is instead.
 func isRecordNotFoundError(err error) bool {
-	// This would typically check for GORM's specific RecordNotFound error
-	// For now, we'll do a simple string check
-	return err != nil && err.Error() == "record not found"
+	for ; err != nil; err = errors.Unwrap(err) {
+		if err.Error() == "record not found" {
+			return true
+		}
+	}
+	return false
 }
 
 // Verify that GormAdapter implements both GORM's logger interface and our DatabaseAdapter interface
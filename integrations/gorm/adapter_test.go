@@ -3,10 +3,12 @@ package gorm
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 // Mock logger for testing
@@ -39,6 +41,11 @@ func (m *mockLogger) Errorw(msg string, keysAndValues ...interface{}) {
 	m.errorCalls = append(m.errorCalls, mockCall{msg: msg, fields: keysAndValues})
 }
 func (m *mockLogger) Fatalw(msg string, keysAndValues ...interface{}) {}
+func (m *mockLogger) DebugF(msg string, fs ...fields.Field) {}
+func (m *mockLogger) InfoF(msg string, fs ...fields.Field)  {}
+func (m *mockLogger) WarnF(msg string, fs ...fields.Field)  {}
+func (m *mockLogger) ErrorF(msg string, fs ...fields.Field) {}
+func (m *mockLogger) FatalF(msg string, fs ...fields.Field) {}
 func (m *mockLogger) SetLevel(level core.Level)                       {}
 
 func (m *mockLogger) Infow(msg string, keysAndValues ...interface{}) {
@@ -57,6 +64,14 @@ func (m *mockLogger) WithCallerSkip(skip int) core.Logger {
 	return m
 }
 
+func (m *mockLogger) WithGroup(name string) core.Logger {
+	return m
+}
+
+func (m *mockLogger) Named(name string) core.Logger {
+	return m
+}
+
 func TestNewGormAdapter(t *testing.T) {
 	mockLog := &mockLogger{}
 	adapter := NewGormAdapter(mockLog)
@@ -241,6 +256,16 @@ func TestGormAdapter_Trace_RecordNotFoundError(t *testing.T) {
 	}
 }
 
+func TestIsRecordNotFoundError_Wrapped(t *testing.T) {
+	err := fmt.Errorf("find user: %w", errors.New("record not found"))
+	if !isRecordNotFoundError(err) {
+		t.Error("Expected wrapped record not found error to match")
+	}
+	if isRecordNotFoundError(errors.New("connection refused")) || isRecordNotFoundError(nil) {
+		t.Error("Expected other errors not to match")
+	}
+}
+
 func TestGormAdapter_LogQuery(t *testing.T) {
 	mockLog := &mockLogger{}
 	adapter := NewGormAdapter(mockLog)
@@ -0,0 +1,183 @@
+// Package gormlogger adapts the unified logger to GORM's logger.Interface.
+//
+// It lives in its own module so that only projects using GORM depend on it.
+package gormlogger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/utils"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// RedactedValue replaces SQL parameters when RedactParams is enabled
+const RedactedValue = "[REDACTED]"
+
+// Config holds configuration for the GORM logger
+type Config struct {
+	// LogLevel is the GORM log level (Silent, Error, Warn or Info)
+	LogLevel gormlogger.LogLevel
+
+	// SlowThreshold logs queries slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// IgnoreRecordNotFoundError skips errors matching gorm.ErrRecordNotFound
+	IgnoreRecordNotFoundError bool
+
+	// ParameterizedQueries logs SQL with placeholders instead of inlined parameters
+	ParameterizedQueries bool
+
+	// RedactParams inlines RedactedValue in place of every SQL parameter
+	RedactParams bool
+
+	// TraceIDFromContext extracts the trace ID from the context passed to GORM
+	// (default: integrations.TraceIDFromContext)
+	TraceIDFromContext func(ctx context.Context) string
+}
+
+// DefaultConfig returns default configuration for the GORM logger
+func DefaultConfig() Config {
+	return Config{
+		LogLevel:                  gormlogger.Warn,
+		SlowThreshold:             200 * time.Millisecond,
+		IgnoreRecordNotFoundError: true,
+		TraceIDFromContext:        integrations.TraceIDFromContext,
+	}
+}
+
+// Logger implements gorm.io/gorm/logger.Interface using the unified logger
+type Logger struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// New creates a GORM logger with the default configuration
+func New(coreLogger core.Logger) *Logger {
+	return NewWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewWithConfig creates a GORM logger with configuration
+func NewWithConfig(coreLogger core.Logger, config Config) *Logger {
+	if config.TraceIDFromContext == nil {
+		config.TraceIDFromContext = integrations.TraceIDFromContext
+	}
+
+	return &Logger{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "GORM", "v1.25+"),
+		config:      config,
+	}
+}
+
+// LogMode returns a copy of the logger using the given level
+func (l *Logger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	newLogger := *l
+	newLogger.config.LogLevel = level
+	return &newLogger
+}
+
+// Info logs GORM informational messages
+func (l *Logger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.config.LogLevel >= gormlogger.Info {
+		l.loggerFor(ctx).Infow(fmt.Sprintf(msg, data...), "component", "gorm", "sql_caller", utils.FileWithLineNum())
+	}
+}
+
+// Warn logs GORM warnings
+func (l *Logger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.config.LogLevel >= gormlogger.Warn {
+		l.loggerFor(ctx).Warnw(fmt.Sprintf(msg, data...), "component", "gorm", "sql_caller", utils.FileWithLineNum())
+	}
+}
+
+// Error logs GORM errors
+func (l *Logger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.config.LogLevel >= gormlogger.Error {
+		l.loggerFor(ctx).Errorw(fmt.Sprintf(msg, data...), "component", "gorm", "sql_caller", utils.FileWithLineNum())
+	}
+}
+
+// Trace logs an executed SQL statement, choosing the level from its error and duration
+func (l *Logger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.config.LogLevel <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	isError := err != nil && l.config.LogLevel >= gormlogger.Error &&
+		(!l.config.IgnoreRecordNotFoundError || !errors.Is(err, gorm.ErrRecordNotFound))
+	isSlow := l.config.SlowThreshold != 0 && elapsed > l.config.SlowThreshold && l.config.LogLevel >= gormlogger.Warn
+	if !isError && !isSlow && l.config.LogLevel < gormlogger.Info {
+		return
+	}
+
+	sql, rows := fc()
+	logFields := []interface{}{
+		"component", "gorm",
+		"sql", sql,
+		"duration_ms", float64(elapsed.Nanoseconds()) / 1e6,
+		"sql_caller", utils.FileWithLineNum(),
+	}
+	if rows >= 0 {
+		logFields = append(logFields, "rows", rows)
+	}
+
+	reqLogger := l.loggerFor(ctx)
+	switch {
+	case isError:
+		logFields = append(logFields, "operation", "query", fields.ErrorField, err.Error())
+		reqLogger.Errorw("Database query failed", logFields...)
+	case isSlow:
+		logFields = append(logFields,
+			"operation", "slow_query",
+			"threshold_ms", float64(l.config.SlowThreshold.Nanoseconds())/1e6,
+		)
+		reqLogger.Warnw("Slow database query detected", logFields...)
+	default:
+		logFields = append(logFields, "operation", "query")
+		reqLogger.Infow("Database query executed", logFields...)
+	}
+}
+
+// ParamsFilter implements gorm.ParamsFilter so statements are logged with
+// placeholders or redacted parameters instead of the raw values
+func (l *Logger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	if l.config.ParameterizedQueries {
+		return sql, nil
+	}
+	if l.config.RedactParams {
+		redacted := make([]interface{}, len(params))
+		for i := range redacted {
+			redacted[i] = RedactedValue
+		}
+		return sql, redacted
+	}
+	return sql, params
+}
+
+// loggerFor returns a logger carrying the trace and request IDs found in ctx
+func (l *Logger) loggerFor(ctx context.Context) core.Logger {
+	var keysAndValues []interface{}
+	if ctx != nil {
+		if traceID := l.config.TraceIDFromContext(ctx); traceID != "" {
+			keysAndValues = append(keysAndValues, fields.TraceIDField, traceID)
+		}
+		if requestID := integrations.RequestIDFromContext(ctx); requestID != "" {
+			keysAndValues = append(keysAndValues, fields.RequestIDField, requestID)
+		}
+	} else {
+		ctx = context.Background()
+	}
+	return l.GetLogger().WithCtx(ctx, keysAndValues...)
+}
+
+// Verify that Logger satisfies GORM's logger and parameter filter interfaces
+var _ gormlogger.Interface = (*Logger)(nil)
+var _ gorm.ParamsFilter = (*Logger)(nil)
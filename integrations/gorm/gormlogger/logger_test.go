@@ -0,0 +1,166 @@
+package gormlogger
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+type user struct {
+	ID    uint
+	Name  string
+	Email string
+}
+
+func openDB(t *testing.T, recorder *logtest.Logger, config Config) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: NewWithConfig(recorder, config)})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&user{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+	recorder.Reset()
+	return db
+}
+
+func TestLogger_LogsQueriesWithTraceID(t *testing.T) {
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.LogLevel = gormlogger.Info
+	db := openDB(t, recorder, config)
+
+	ctx := integrations.ContextWithTraceID(context.Background(), "trace-1")
+	ctx = integrations.ContextWithRequestID(ctx, "req-1")
+	if err := db.WithContext(ctx).Create(&user{Name: "alice", Email: "alice@example.com"}).Error; err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Level != core.InfoLevel || entry.Fields["trace_id"] != "trace-1" || entry.Fields["request_id"] != "req-1" {
+		t.Errorf("Expected info entry with trace and request IDs, got %+v", entry)
+	}
+	if sql, _ := entry.Fields["sql"].(string); !strings.Contains(sql, `"alice@example.com"`) {
+		t.Errorf("Expected parameters inlined by default, got %q", sql)
+	}
+	if entry.Fields["rows"] != int64(1) {
+		t.Errorf("Expected rows=1, got %v", entry.Fields["rows"])
+	}
+	if caller, _ := entry.Fields["sql_caller"].(string); !strings.Contains(caller, "logger_test.go") {
+		t.Errorf("Expected caller in test file, got %q", caller)
+	}
+	if _, ok := entry.Fields["caller"]; ok {
+		t.Errorf("Expected the engine's caller key to be left to the engine, got %+v", entry.Fields)
+	}
+}
+
+func TestLogger_RecordNotFound(t *testing.T) {
+	recorder := logtest.New()
+	db := openDB(t, recorder, DefaultConfig())
+
+	var u user
+	if err := db.First(&u, 42).Error; err != gorm.ErrRecordNotFound {
+		t.Fatalf("Expected ErrRecordNotFound, got %v", err)
+	}
+	if entries := recorder.Entries(); len(entries) != 0 {
+		t.Errorf("Expected record not found to be ignored, got %+v", entries)
+	}
+
+	config := DefaultConfig()
+	config.IgnoreRecordNotFoundError = false
+	db = openDB(t, recorder, config)
+	db.First(&u, 42)
+
+	entries := recorder.Entries()
+	if len(entries) != 1 || entries[0].Level != core.ErrorLevel || entries[0].Fields["error"] != "record not found" {
+		t.Errorf("Expected record not found error entry, got %+v", entries)
+	}
+}
+
+func TestLogger_ErrorsAndSilent(t *testing.T) {
+	recorder := logtest.New()
+	db := openDB(t, recorder, DefaultConfig())
+
+	db.Exec("SELECT * FROM missing_table")
+	entries := recorder.Entries()
+	if len(entries) != 1 || entries[0].Level != core.ErrorLevel || entries[0].Fields["operation"] != "query" {
+		t.Fatalf("Expected query error entry, got %+v", entries)
+	}
+
+	recorder.Reset()
+	db.Session(&gorm.Session{Logger: db.Logger.LogMode(gormlogger.Silent)}).Exec("SELECT * FROM missing_table")
+	if entries := recorder.Entries(); len(entries) != 0 {
+		t.Errorf("Expected silent mode to suppress logging, got %+v", entries)
+	}
+}
+
+func TestLogger_ParameterizedAndRedacted(t *testing.T) {
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.LogLevel = gormlogger.Info
+	config.ParameterizedQueries = true
+	db := openDB(t, recorder, config)
+
+	db.Where("email = ?", "secret@example.com").Find(&[]user{})
+	sql, _ := recorder.Entries()[0].Fields["sql"].(string)
+	if !strings.Contains(sql, "email = ?") || strings.Contains(sql, "secret") {
+		t.Errorf("Expected parameterized SQL, got %q", sql)
+	}
+
+	config.ParameterizedQueries = false
+	config.RedactParams = true
+	db = openDB(t, recorder, config)
+
+	db.Where("email = ?", "secret@example.com").Find(&[]user{})
+	sql, _ = recorder.Entries()[0].Fields["sql"].(string)
+	if !strings.Contains(sql, RedactedValue) || strings.Contains(sql, "secret") {
+		t.Errorf("Expected redacted SQL, got %q", sql)
+	}
+}
+
+func TestLogger_SlowQuery(t *testing.T) {
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.SlowThreshold = time.Millisecond
+	l := NewWithConfig(recorder, config)
+
+	l.Trace(context.Background(), time.Now().Add(-10*time.Millisecond), func() (string, int64) {
+		return "SELECT 1", -1
+	}, nil)
+
+	entries := recorder.Entries()
+	if len(entries) != 1 || entries[0].Level != core.WarnLevel || entries[0].Fields["operation"] != "slow_query" {
+		t.Fatalf("Expected slow query warning, got %+v", entries)
+	}
+	if _, ok := entries[0].Fields["rows"]; ok {
+		t.Error("Expected unknown row count to be omitted")
+	}
+}
+
+func TestLogger_MessageMethods(t *testing.T) {
+	recorder := logtest.New()
+	l := New(recorder)
+
+	l.Info(context.Background(), "hidden %d", 1)
+	l.Warn(context.Background(), "careful %s", "now")
+	l.Error(context.Background(), "broken %v", true)
+
+	entries := recorder.Entries()
+	if len(entries) != 2 || entries[0].Msg != "careful now" || entries[1].Msg != "broken true" {
+		t.Errorf("Expected warn and error messages at default warn level, got %+v", entries)
+	}
+}
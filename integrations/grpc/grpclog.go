@@ -0,0 +1,167 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/grpclog"
+
+	"github.com/kart-io/logger/core"
+)
+
+// grpcLogCallerSkip skips GRPCLogger.log, the LoggerV2 method and the grpclog
+// package function so the reported caller is the code inside gRPC that logged.
+const grpcLogCallerSkip = 3
+
+// LoggerV2Config configures the grpclog bridge
+type LoggerV2Config struct {
+	// Verbosity is the highest verbosity level V reports as enabled
+	Verbosity int
+
+	// InfoLevel is the core level used for gRPC info messages; set it to
+	// core.DebugLevel to keep gRPC chatter out of info output
+	InfoLevel core.Level
+}
+
+// DefaultLoggerV2Config returns default configuration for the grpclog bridge
+func DefaultLoggerV2Config() LoggerV2Config {
+	return LoggerV2Config{
+		Verbosity: 0,
+		InfoLevel: core.InfoLevel,
+	}
+}
+
+// GRPCLogger implements grpclog.LoggerV2 and grpclog.DepthLoggerV2 so gRPC's
+// internal logs are written through a core.Logger
+type GRPCLogger struct {
+	logger core.Logger
+	plain  core.Logger
+	config LoggerV2Config
+}
+
+// NewGRPCLogger creates a grpclog bridge backed by logger
+func NewGRPCLogger(logger core.Logger, config LoggerV2Config) *GRPCLogger {
+	return &GRPCLogger{
+		logger: logger,
+		plain:  logger.WithCallerSkip(grpcLogCallerSkip),
+		config: config,
+	}
+}
+
+// ReplaceGRPCLogger installs a bridge backed by logger as gRPC's global logger.
+// Like grpclog.SetLoggerV2 it must be called before any gRPC function, for
+// example from an init function.
+func ReplaceGRPCLogger(logger core.Logger, config LoggerV2Config) {
+	grpclog.SetLoggerV2(NewGRPCLogger(logger, config))
+}
+
+// Info logs to the info log
+func (g *GRPCLogger) Info(args ...interface{}) {
+	g.log(g.plain, g.config.InfoLevel, fmt.Sprint(args...))
+}
+
+// Infoln logs to the info log
+func (g *GRPCLogger) Infoln(args ...interface{}) {
+	g.log(g.plain, g.config.InfoLevel, sprintln(args...))
+}
+
+// Infof logs to the info log
+func (g *GRPCLogger) Infof(format string, args ...interface{}) {
+	g.log(g.plain, g.config.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+// Warning logs to the warning log
+func (g *GRPCLogger) Warning(args ...interface{}) {
+	g.log(g.plain, core.WarnLevel, fmt.Sprint(args...))
+}
+
+// Warningln logs to the warning log
+func (g *GRPCLogger) Warningln(args ...interface{}) {
+	g.log(g.plain, core.WarnLevel, sprintln(args...))
+}
+
+// Warningf logs to the warning log
+func (g *GRPCLogger) Warningf(format string, args ...interface{}) {
+	g.log(g.plain, core.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+// Error logs to the error log
+func (g *GRPCLogger) Error(args ...interface{}) {
+	g.log(g.plain, core.ErrorLevel, fmt.Sprint(args...))
+}
+
+// Errorln logs to the error log
+func (g *GRPCLogger) Errorln(args ...interface{}) {
+	g.log(g.plain, core.ErrorLevel, sprintln(args...))
+}
+
+// Errorf logs to the error log
+func (g *GRPCLogger) Errorf(format string, args ...interface{}) {
+	g.log(g.plain, core.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+// Fatal logs to the fatal log and exits
+func (g *GRPCLogger) Fatal(args ...interface{}) {
+	g.log(g.plain, core.FatalLevel, fmt.Sprint(args...))
+}
+
+// Fatalln logs to the fatal log and exits
+func (g *GRPCLogger) Fatalln(args ...interface{}) {
+	g.log(g.plain, core.FatalLevel, sprintln(args...))
+}
+
+// Fatalf logs to the fatal log and exits
+func (g *GRPCLogger) Fatalf(format string, args ...interface{}) {
+	g.log(g.plain, core.FatalLevel, fmt.Sprintf(format, args...))
+}
+
+// V reports whether verbosity level l is enabled
+func (g *GRPCLogger) V(l int) bool {
+	return l <= g.config.Verbosity
+}
+
+// InfoDepth logs to the info log at the specified depth
+func (g *GRPCLogger) InfoDepth(depth int, args ...interface{}) {
+	g.log(g.logger.WithCallerSkip(grpcLogCallerSkip+depth), g.config.InfoLevel, fmt.Sprint(args...))
+}
+
+// WarningDepth logs to the warning log at the specified depth
+func (g *GRPCLogger) WarningDepth(depth int, args ...interface{}) {
+	g.log(g.logger.WithCallerSkip(grpcLogCallerSkip+depth), core.WarnLevel, fmt.Sprint(args...))
+}
+
+// ErrorDepth logs to the error log at the specified depth
+func (g *GRPCLogger) ErrorDepth(depth int, args ...interface{}) {
+	g.log(g.logger.WithCallerSkip(grpcLogCallerSkip+depth), core.ErrorLevel, fmt.Sprint(args...))
+}
+
+// FatalDepth logs to the fatal log at the specified depth and exits
+func (g *GRPCLogger) FatalDepth(depth int, args ...interface{}) {
+	g.log(g.logger.WithCallerSkip(grpcLogCallerSkip+depth), core.FatalLevel, fmt.Sprint(args...))
+}
+
+func (g *GRPCLogger) log(logger core.Logger, level core.Level, msg string) {
+	switch level {
+	case core.DebugLevel:
+		logger.Debugw(msg, "component", "grpc")
+	case core.WarnLevel:
+		logger.Warnw(msg, "component", "grpc")
+	case core.ErrorLevel:
+		logger.Errorw(msg, "component", "grpc")
+	case core.FatalLevel:
+		logger.Fatalw(msg, "component", "grpc")
+	default:
+		logger.Infow(msg, "component", "grpc")
+	}
+}
+
+// sprintln formats like fmt.Sprintln without the trailing newline
+func sprintln(args ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
+
+// Verify that GRPCLogger implements grpclog's logger interfaces
+var (
+	_ grpclog.LoggerV2      = (*GRPCLogger)(nil)
+	_ grpclog.DepthLoggerV2 = (*GRPCLogger)(nil)
+)
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/grpclog"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+	"github.com/kart-io/logger/option"
+)
+
+func TestGRPCLogger_Verbosity(t *testing.T) {
+	config := DefaultLoggerV2Config()
+	config.Verbosity = 2
+	l := NewGRPCLogger(logtest.New(), config)
+
+	if !l.V(2) || l.V(3) {
+		t.Error("Expected V to report levels up to the configured verbosity")
+	}
+}
+
+func TestGRPCLogger_Levels(t *testing.T) {
+	recorder := logtest.New()
+	config := DefaultLoggerV2Config()
+	config.InfoLevel = core.DebugLevel
+	l := NewGRPCLogger(recorder, config)
+
+	l.Infoln("channel", "created")
+	l.Warningf("retrying %d", 3)
+	l.ErrorDepth(1, "transport", " closed")
+
+	entries := recorder.Entries()
+	expected := []struct {
+		level core.Level
+		msg   string
+	}{
+		{core.DebugLevel, "channel created"},
+		{core.WarnLevel, "retrying 3"},
+		{core.ErrorLevel, "transport closed"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, want := range expected {
+		if entries[i].Level != want.level || entries[i].Msg != want.msg || entries[i].Fields["component"] != "grpc" {
+			t.Errorf("Entry %d = %+v, want %s %q", i, entries[i], want.level, want.msg)
+		}
+	}
+}
+
+func TestReplaceGRPCLogger_CallerDepth(t *testing.T) {
+	for _, engine := range []string{"zap", "slog"} {
+		t.Run(engine, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.OutputPaths = []string{logFile}
+
+			coreLogger, err := logger.New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			ReplaceGRPCLogger(coreLogger, DefaultLoggerV2Config())
+			defer grpclog.SetLoggerV2(grpclog.NewLoggerV2(io.Discard, io.Discard, os.Stderr))
+
+			grpclog.Info("package level")
+			grpclog.Component("test").Warning("component level")
+
+			data, err := os.ReadFile(logFile)
+			if err != nil {
+				t.Fatalf("Failed to read log file: %v", err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("Expected 2 entries, got %d: %s", len(lines), data)
+			}
+			for _, line := range lines {
+				var entry map[string]interface{}
+				if err := json.Unmarshal([]byte(line), &entry); err != nil {
+					t.Fatalf("Failed to parse %q: %v", line, err)
+				}
+				if caller, _ := entry["caller"].(string); !strings.Contains(caller, "grpclog_test.go") {
+					t.Errorf("Expected caller in test file, got %q", caller)
+				}
+			}
+		})
+	}
+}
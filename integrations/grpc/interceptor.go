@@ -0,0 +1,539 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// RedactedValue replaces the value of redacted payload fields
+const RedactedValue = "[REDACTED]"
+
+// Config holds configuration for the gRPC interceptors
+type Config struct {
+	// SkipMethods are full method names (e.g. "/grpc.health.v1.Health/Check")
+	// that are not logged. Entries ending in "*" match by prefix.
+	SkipMethods []string
+
+	// RequestIDMetadataKey is the metadata key carrying request IDs (default: x-request-id)
+	RequestIDMetadataKey string
+
+	// TraceIDMetadataKey is the metadata key carrying trace IDs (default: x-trace-id)
+	TraceIDMetadataKey string
+
+	// GenerateRequestID creates a request ID when an incoming call carries none
+	GenerateRequestID func() string
+
+	// CodeLevels overrides the log level for specific status codes.
+	// Codes without an entry use DefaultCodeToLevel.
+	CodeLevels map[codes.Code]core.Level
+
+	// LogPayloads logs request and response messages
+	LogPayloads bool
+
+	// RedactFields are message field names (JSON or proto names, case-insensitive)
+	// whose values are replaced with RedactedValue when payloads are logged
+	RedactFields []string
+}
+
+// DefaultConfig returns default configuration for the gRPC interceptors
+func DefaultConfig() Config {
+	return Config{
+		RequestIDMetadataKey: "x-request-id",
+		TraceIDMetadataKey:   "x-trace-id",
+		GenerateRequestID:    integrations.NewRequestID,
+	}
+}
+
+// DefaultCodeToLevel maps gRPC status codes to log levels: codes caused by the
+// client are info, codes that may need attention are warn and server faults are error.
+func DefaultCodeToLevel(code codes.Code) core.Level {
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound,
+		codes.AlreadyExists, codes.Unauthenticated:
+		return core.InfoLevel
+	case codes.DeadlineExceeded, codes.PermissionDenied, codes.ResourceExhausted,
+		codes.FailedPrecondition, codes.Aborted, codes.OutOfRange, codes.Unavailable:
+		return core.WarnLevel
+	default:
+		return core.ErrorLevel
+	}
+}
+
+// GRPCAdapter provides gRPC interceptors that log through the unified logger
+type GRPCAdapter struct {
+	*integrations.BaseAdapter
+	config Config
+	redact map[string]bool
+}
+
+// NewGRPCAdapter creates a new gRPC adapter
+func NewGRPCAdapter(coreLogger core.Logger) *GRPCAdapter {
+	return NewGRPCAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewGRPCAdapterWithConfig creates a new gRPC adapter with configuration
+func NewGRPCAdapterWithConfig(coreLogger core.Logger, config Config) *GRPCAdapter {
+	defaults := DefaultConfig()
+	if config.RequestIDMetadataKey == "" {
+		config.RequestIDMetadataKey = defaults.RequestIDMetadataKey
+	}
+	if config.TraceIDMetadataKey == "" {
+		config.TraceIDMetadataKey = defaults.TraceIDMetadataKey
+	}
+	if config.GenerateRequestID == nil {
+		config.GenerateRequestID = defaults.GenerateRequestID
+	}
+
+	redact := make(map[string]bool, len(config.RedactFields))
+	for _, name := range config.RedactFields {
+		redact[normalizeFieldName(name)] = true
+	}
+
+	return &GRPCAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "gRPC", "v1.x"),
+		config:      config,
+		redact:      redact,
+	}
+}
+
+// FromContext returns the request-scoped logger attached by the server
+// interceptors, or the global logger when the context carries none
+func FromContext(ctx context.Context) core.Logger {
+	if l, ok := integrations.LoggerFromContext(ctx); ok {
+		return l
+	}
+	return logger.Global()
+}
+
+// UnaryServerInterceptor logs unary calls and attaches a request-scoped logger to the context
+func (g *GRPCAdapter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if integrations.MatchPath(info.FullMethod, g.config.SkipMethods) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		ctx, reqLogger := g.serverContext(ctx)
+
+		resp, err := handler(ctx, req)
+
+		logFields := g.callFields("server", "unary", info.FullMethod, peerAddress(ctx))
+		logFields = append(logFields, "request_bytes", messageSize(req))
+		if err == nil {
+			logFields = append(logFields, "response_bytes", messageSize(resp))
+		}
+		if g.config.LogPayloads {
+			logFields = append(logFields, "request", g.payload(req))
+			if err == nil {
+				logFields = append(logFields, "response", g.payload(resp))
+			}
+		}
+
+		g.logCall(reqLogger, info.FullMethod, err, time.Since(start), logFields)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor logs streaming calls and attaches a request-scoped logger to the stream context
+func (g *GRPCAdapter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if integrations.MatchPath(info.FullMethod, g.config.SkipMethods) {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		ctx, reqLogger := g.serverContext(ss.Context())
+		wrapped := &serverStream{ServerStream: ss, ctx: ctx, adapter: g, logger: reqLogger}
+
+		err := handler(srv, wrapped)
+
+		logFields := g.callFields("server", streamType(info.IsClientStream, info.IsServerStream), info.FullMethod, peerAddress(ctx))
+		logFields = append(logFields, wrapped.counters.fields()...)
+		g.logCall(reqLogger, info.FullMethod, err, time.Since(start), logFields)
+		return err
+	}
+}
+
+// UnaryClientInterceptor logs outgoing unary calls and propagates request and trace IDs via metadata
+func (g *GRPCAdapter) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if integrations.MatchPath(method, g.config.SkipMethods) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		start := time.Now()
+		ctx, reqLogger := g.clientContext(ctx)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		logFields := g.callFields("client", "unary", method, cc.Target())
+		logFields = append(logFields, "request_bytes", messageSize(req))
+		if err == nil {
+			logFields = append(logFields, "response_bytes", messageSize(reply))
+		}
+		if g.config.LogPayloads {
+			logFields = append(logFields, "request", g.payload(req))
+			if err == nil {
+				logFields = append(logFields, "response", g.payload(reply))
+			}
+		}
+
+		g.logCall(reqLogger, method, err, time.Since(start), logFields)
+		return err
+	}
+}
+
+// StreamClientInterceptor logs outgoing streaming calls once the stream ends
+func (g *GRPCAdapter) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if integrations.MatchPath(method, g.config.SkipMethods) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		start := time.Now()
+		ctx, reqLogger := g.clientContext(ctx)
+		baseFields := g.callFields("client", streamType(desc.ClientStreams, desc.ServerStreams), method, cc.Target())
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			g.logCall(reqLogger, method, err, time.Since(start), baseFields)
+			return nil, err
+		}
+
+		return &clientStream{
+			ClientStream: cs,
+			adapter:      g,
+			logger:       reqLogger,
+			method:       method,
+			start:        start,
+			fields:       baseFields,
+			singleRecv:   !desc.ServerStreams,
+		}, nil
+	}
+}
+
+// serverContext extracts request and trace IDs from incoming metadata and
+// returns a context carrying them together with a request-scoped logger
+func (g *GRPCAdapter) serverContext(ctx context.Context) (context.Context, core.Logger) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(md, g.config.RequestIDMetadataKey)
+	if requestID == "" {
+		requestID = g.config.GenerateRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(g.config.RequestIDMetadataKey, requestID))
+
+	ctx = integrations.ContextWithRequestID(ctx, requestID)
+	keysAndValues := []interface{}{fields.RequestIDField, requestID}
+	if traceID := firstValue(md, g.config.TraceIDMetadataKey); traceID != "" {
+		ctx = integrations.ContextWithTraceID(ctx, traceID)
+		keysAndValues = append(keysAndValues, fields.TraceIDField, traceID)
+	}
+
+	reqLogger := g.GetLogger().WithCtx(ctx, keysAndValues...)
+	return integrations.ContextWithLogger(ctx, reqLogger), reqLogger
+}
+
+// clientContext appends the request and trace IDs of the current call chain
+// to the outgoing metadata
+func (g *GRPCAdapter) clientContext(ctx context.Context) (context.Context, core.Logger) {
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+	incoming, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(outgoing, g.config.RequestIDMetadataKey)
+	if requestID == "" {
+		requestID = integrations.RequestIDFromContext(ctx)
+		if requestID == "" {
+			requestID = firstValue(incoming, g.config.RequestIDMetadataKey)
+		}
+		if requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, g.config.RequestIDMetadataKey, requestID)
+		}
+	}
+
+	traceID := firstValue(outgoing, g.config.TraceIDMetadataKey)
+	if traceID == "" {
+		traceID = integrations.TraceIDFromContext(ctx)
+		if traceID == "" {
+			traceID = firstValue(incoming, g.config.TraceIDMetadataKey)
+		}
+		if traceID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, g.config.TraceIDMetadataKey, traceID)
+		}
+	}
+
+	var keysAndValues []interface{}
+	if requestID != "" {
+		keysAndValues = append(keysAndValues, fields.RequestIDField, requestID)
+	}
+	if traceID != "" {
+		keysAndValues = append(keysAndValues, fields.TraceIDField, traceID)
+	}
+	return ctx, g.GetLogger().WithCtx(ctx, keysAndValues...)
+}
+
+func (g *GRPCAdapter) callFields(kind, callType, fullMethod, peerAddr string) []interface{} {
+	service, method := splitMethod(fullMethod)
+	logFields := []interface{}{
+		"component", "grpc",
+		"grpc.kind", kind,
+		"grpc.type", callType,
+		"grpc.service", service,
+		"grpc.method", method,
+	}
+	if peerAddr != "" {
+		logFields = append(logFields, "peer", peerAddr)
+	}
+	return logFields
+}
+
+func (g *GRPCAdapter) logCall(reqLogger core.Logger, fullMethod string, err error, duration time.Duration, logFields []interface{}) {
+	code := status.Code(err)
+	logFields = append(logFields,
+		"grpc.code", code.String(),
+		"duration_ms", float64(duration.Nanoseconds())/1e6,
+	)
+	if err != nil {
+		logFields = append(logFields, fields.ErrorField, status.Convert(err).Message())
+	}
+
+	integrations.LogAtLevel(reqLogger, g.levelForCode(code), "gRPC "+fullMethod, logFields...)
+}
+
+func (g *GRPCAdapter) levelForCode(code codes.Code) core.Level {
+	if level, ok := g.config.CodeLevels[code]; ok {
+		return level
+	}
+	return DefaultCodeToLevel(code)
+}
+
+// payload converts a message to a loggable value with redacted fields masked.
+// When fields are redacted, payloads that cannot be inspected are masked as a
+// whole.
+func (g *GRPCAdapter) payload(msg interface{}) interface{} {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		if len(g.redact) > 0 {
+			return RedactedValue
+		}
+		return msg
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(pm)
+	if err != nil {
+		return nil
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		if len(g.redact) > 0 {
+			return RedactedValue
+		}
+		return string(data)
+	}
+	if len(g.redact) > 0 {
+		g.redactValue(decoded)
+	}
+	return decoded
+}
+
+func (g *GRPCAdapter) redactValue(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if g.redact[normalizeFieldName(key)] {
+				v[key] = RedactedValue
+				continue
+			}
+			g.redactValue(nested)
+		}
+	case []interface{}:
+		for _, nested := range v {
+			g.redactValue(nested)
+		}
+	}
+}
+
+// streamCounters tracks messages and payload bytes on a stream. SendMsg and
+// RecvMsg may run concurrently, so the counters are atomic.
+type streamCounters struct {
+	sentMessages     atomic.Int64
+	receivedMessages atomic.Int64
+	sentBytes        atomic.Int64
+	receivedBytes    atomic.Int64
+}
+
+func (c *streamCounters) sent(msg interface{}) {
+	c.sentMessages.Add(1)
+	c.sentBytes.Add(int64(messageSize(msg)))
+}
+
+func (c *streamCounters) received(msg interface{}) {
+	c.receivedMessages.Add(1)
+	c.receivedBytes.Add(int64(messageSize(msg)))
+}
+
+func (c *streamCounters) fields() []interface{} {
+	return []interface{}{
+		"sent_messages", int(c.sentMessages.Load()),
+		"received_messages", int(c.receivedMessages.Load()),
+		"request_bytes", int(c.receivedBytes.Load()),
+		"response_bytes", int(c.sentBytes.Load()),
+	}
+}
+
+// serverStream overrides the stream context and counts messages
+type serverStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	adapter  *GRPCAdapter
+	logger   core.Logger
+	counters streamCounters
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.counters.sent(m)
+		if s.adapter.config.LogPayloads {
+			s.logger.Debugw("gRPC stream message sent", "component", "grpc", "response", s.adapter.payload(m))
+		}
+	}
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.counters.received(m)
+		if s.adapter.config.LogPayloads {
+			s.logger.Debugw("gRPC stream message received", "component", "grpc", "request", s.adapter.payload(m))
+		}
+	}
+	return err
+}
+
+// clientStream counts messages and logs the call when the stream finishes
+type clientStream struct {
+	grpc.ClientStream
+	adapter  *GRPCAdapter
+	logger   core.Logger
+	method   string
+	start    time.Time
+	fields   []interface{}
+	counters streamCounters
+	once     sync.Once
+
+	// singleRecv is set for unary-response streams, whose generated
+	// CloseAndRecv receives the response once and never sees io.EOF
+	singleRecv bool
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.counters.sent(m)
+	} else if err != io.EOF {
+		s.finish(err)
+	}
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.counters.received(m)
+		if s.singleRecv {
+			s.finish(nil)
+		}
+	case err == io.EOF:
+		s.finish(nil)
+	default:
+		s.finish(err)
+	}
+	return err
+}
+
+// finish logs the call once, whichever of SendMsg and RecvMsg ends the stream
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		// From the client's perspective requests are sent and responses received
+		logFields := append(append([]interface{}{}, s.fields...),
+			"sent_messages", int(s.counters.sentMessages.Load()),
+			"received_messages", int(s.counters.receivedMessages.Load()),
+			"request_bytes", int(s.counters.sentBytes.Load()),
+			"response_bytes", int(s.counters.receivedBytes.Load()),
+		)
+		s.adapter.logCall(s.logger, s.method, err, time.Since(s.start), logFields)
+	})
+}
+
+func messageSize(msg interface{}) int {
+	if pm, ok := msg.(proto.Message); ok {
+		return proto.Size(pm)
+	}
+	return 0
+}
+
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", path.Base(fullMethod)
+}
+
+func streamType(clientStream, serverStream bool) string {
+	switch {
+	case clientStream && serverStream:
+		return "bidi_stream"
+	case clientStream:
+		return "client_stream"
+	case serverStream:
+		return "server_stream"
+	default:
+		return "unary"
+	}
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,362 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+// seenRequestIDs wraps the health server and records the request ID visible to handlers
+type seenRequestIDs struct {
+	*health.Server
+	mu  sync.Mutex
+	ids []string
+}
+
+func (s *seenRequestIDs) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.mu.Lock()
+	s.ids = append(s.ids, integrations.RequestIDFromContext(ctx))
+	s.mu.Unlock()
+	FromContext(ctx).Infow("checking health")
+	return s.Server.Check(ctx, req)
+}
+
+func startServer(t *testing.T, serverLogger, clientLogger core.Logger, config Config) (healthpb.HealthClient, *seenRequestIDs) {
+	t.Helper()
+
+	svc := &seenRequestIDs{Server: health.NewServer()}
+	svc.SetServingStatus("payments", healthpb.HealthCheckResponse_SERVING)
+	conn := serve(t, serverLogger, clientLogger, config, func(server *grpc.Server) {
+		healthpb.RegisterHealthServer(server, svc)
+	})
+	return healthpb.NewHealthClient(conn), svc
+}
+
+// serve starts an intercepted server over bufconn with the services added by
+// register, and returns a client connection using the client interceptors
+func serve(t *testing.T, serverLogger, clientLogger core.Logger, config Config, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	serverAdapter := NewGRPCAdapterWithConfig(serverLogger, config)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(serverAdapter.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(serverAdapter.StreamServerInterceptor()),
+	)
+	register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	clientAdapter := NewGRPCAdapterWithConfig(clientLogger, config)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(clientAdapter.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(clientAdapter.StreamClientInterceptor()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestDefaultCodeToLevel(t *testing.T) {
+	tests := map[codes.Code]core.Level{
+		codes.OK:               core.InfoLevel,
+		codes.NotFound:         core.InfoLevel,
+		codes.DeadlineExceeded: core.WarnLevel,
+		codes.Unavailable:      core.WarnLevel,
+		codes.Internal:         core.ErrorLevel,
+		codes.Unknown:          core.ErrorLevel,
+	}
+	for code, want := range tests {
+		if got := DefaultCodeToLevel(code); got != want {
+			t.Errorf("DefaultCodeToLevel(%s) = %s, want %s", code, got, want)
+		}
+	}
+}
+
+func TestUnaryInterceptors_LogCalls(t *testing.T) {
+	serverLogger, clientLogger := logtest.New(), logtest.New()
+	client, svc := startServer(t, serverLogger, clientLogger, DefaultConfig())
+
+	ctx := integrations.ContextWithRequestID(context.Background(), "req-1")
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", "trace-1")
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "payments"}); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+
+	if len(svc.ids) != 1 || svc.ids[0] != "req-1" {
+		t.Errorf("Expected request ID to propagate to the handler, got %v", svc.ids)
+	}
+
+	server := find(serverLogger, "server")
+	if len(server) != 1 {
+		t.Fatalf("Expected 1 server call entry, got %d", len(server))
+	}
+	expected := map[string]interface{}{
+		"grpc.service": "grpc.health.v1.Health",
+		"grpc.method":  "Check",
+		"grpc.type":    "unary",
+		"grpc.code":    "OK",
+		"request_id":   "req-1",
+		"trace_id":     "trace-1",
+	}
+	for key, want := range expected {
+		if got := server[0].Fields[key]; got != want {
+			t.Errorf("Server field %s = %v, want %v", key, got, want)
+		}
+	}
+	if server[0].Fields["request_bytes"].(int) == 0 || server[0].Fields["peer"] == nil {
+		t.Errorf("Expected request size and peer, got %v", server[0].Fields)
+	}
+
+	entries := serverLogger.Entries()
+	if entries[0].Msg != "checking health" || entries[0].Fields["request_id"] != "req-1" {
+		t.Errorf("Expected handler logger to carry request ID, got %+v", entries[0])
+	}
+
+	clientEntries := find(clientLogger, "client")
+	if len(clientEntries) != 1 || clientEntries[0].Fields["request_id"] != "req-1" || clientEntries[0].Level != core.InfoLevel {
+		t.Errorf("Expected client call entry with request ID, got %+v", clientEntries)
+	}
+}
+
+func TestUnaryInterceptors_ErrorCodes(t *testing.T) {
+	serverLogger := logtest.New()
+	config := DefaultConfig()
+	config.CodeLevels = map[codes.Code]core.Level{codes.NotFound: core.WarnLevel}
+	client, svc := startServer(t, serverLogger, logtest.New(), config)
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound, got %v", err)
+	}
+	if len(svc.ids) != 1 || svc.ids[0] == "" {
+		t.Errorf("Expected generated request ID, got %v", svc.ids)
+	}
+
+	server := find(serverLogger, "server")
+	if len(server) != 1 {
+		t.Fatalf("Expected 1 server call entry, got %d", len(server))
+	}
+	if server[0].Level != core.WarnLevel || server[0].Fields["grpc.code"] != "NotFound" || server[0].Fields["error"] == nil {
+		t.Errorf("Expected NotFound at configured warn level, got %+v", server[0])
+	}
+}
+
+func TestInterceptors_LogPayloadsWithRedaction(t *testing.T) {
+	serverLogger := logtest.New()
+	config := DefaultConfig()
+	config.LogPayloads = true
+	config.RedactFields = []string{"service"}
+	client, _ := startServer(t, serverLogger, logtest.New(), config)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "payments"}); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+
+	server := find(serverLogger, "server")
+	if len(server) != 1 {
+		t.Fatalf("Expected 1 server call entry, got %d", len(server))
+	}
+	request, ok := server[0].Fields["request"].(map[string]interface{})
+	if !ok || request["service"] != RedactedValue {
+		t.Errorf("Expected redacted request payload, got %v", server[0].Fields["request"])
+	}
+	response, ok := server[0].Fields["response"].(map[string]interface{})
+	if !ok || response["status"] != "SERVING" {
+		t.Errorf("Expected response payload, got %v", server[0].Fields["response"])
+	}
+}
+
+func TestStreamInterceptors_LogCalls(t *testing.T) {
+	serverLogger, clientLogger := logtest.New(), logtest.New()
+	client, _ := startServer(t, serverLogger, clientLogger, DefaultConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", "stream-1")
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: "payments"})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+	if resp, err := stream.Recv(); err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("Expected SERVING update, got %v, %v", resp, err)
+	}
+	cancel()
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Fatalf("Expected Canceled after cancel, got %v", err)
+	}
+
+	clientEntries := find(clientLogger, "client")
+	if len(clientEntries) != 1 {
+		t.Fatalf("Expected 1 client stream entry, got %d", len(clientEntries))
+	}
+	if clientEntries[0].Fields["grpc.type"] != "server_stream" || clientEntries[0].Fields["received_messages"] != 1 ||
+		clientEntries[0].Fields["request_id"] != "stream-1" || clientEntries[0].Fields["grpc.code"] != "Canceled" {
+		t.Errorf("Unexpected client stream entry %+v", clientEntries[0].Fields)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(find(serverLogger, "server")) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	server := find(serverLogger, "server")
+	if len(server) != 1 {
+		t.Fatalf("Expected 1 server stream entry, got %d", len(server))
+	}
+	if server[0].Fields["sent_messages"].(int) < 1 || server[0].Fields["request_id"] != "stream-1" {
+		t.Errorf("Unexpected server stream entry %+v", server[0].Fields)
+	}
+}
+
+// collectDesc is a client-streaming method that counts the requests it receives
+var collectDesc = grpc.StreamDesc{
+	StreamName:    "Collect",
+	ClientStreams: true,
+	Handler: func(srv interface{}, stream grpc.ServerStream) error {
+		count := 0
+		for {
+			if err := stream.RecvMsg(&healthpb.HealthCheckRequest{}); err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+			count++
+		}
+		return stream.SendMsg(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_ServingStatus(count)})
+	},
+}
+
+func TestStreamInterceptors_LogClientStream(t *testing.T) {
+	serverLogger, clientLogger := logtest.New(), logtest.New()
+	conn := serve(t, serverLogger, clientLogger, DefaultConfig(), func(server *grpc.Server) {
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: "test.Collector",
+			HandlerType: (*interface{})(nil),
+			Streams:     []grpc.StreamDesc{collectDesc},
+		}, struct{}{})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	stream, err := conn.NewStream(ctx, &collectDesc, "/test.Collector/Collect")
+	if err != nil {
+		t.Fatalf("NewStream failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := stream.SendMsg(&healthpb.HealthCheckRequest{Service: "payments"}); err != nil {
+			t.Fatalf("SendMsg failed: %v", err)
+		}
+	}
+	// Mirror generated CloseAndRecv, which receives exactly once
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+	resp := &healthpb.HealthCheckResponse{}
+	if err := stream.RecvMsg(resp); err != nil || resp.Status != 3 {
+		t.Fatalf("Expected a response counting 3 requests, got %v, %v", resp, err)
+	}
+
+	clientEntries := find(clientLogger, "client")
+	if len(clientEntries) != 1 {
+		t.Fatalf("Expected 1 client stream entry, got %d", len(clientEntries))
+	}
+	fields := clientEntries[0].Fields
+	if fields["grpc.type"] != "client_stream" || fields["sent_messages"] != 3 ||
+		fields["received_messages"] != 1 || fields["grpc.code"] != "OK" {
+		t.Errorf("Unexpected client stream entry %+v", fields)
+	}
+}
+
+// endingStream is a client stream whose SendMsg and RecvMsg both fail
+type endingStream struct {
+	grpc.ClientStream
+}
+
+func (endingStream) SendMsg(m interface{}) error { return status.Error(codes.Unavailable, "gone") }
+func (endingStream) RecvMsg(m interface{}) error { return status.Error(codes.Unavailable, "gone") }
+
+func TestClientStream_ConcurrentEndLogsOnce(t *testing.T) {
+	logger := logtest.New()
+	stream := &clientStream{
+		ClientStream: endingStream{},
+		adapter:      NewGRPCAdapter(logger),
+		logger:       logger,
+		method:       "/grpc.health.v1.Health/Watch",
+		start:        time.Now(),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); stream.SendMsg(&healthpb.HealthCheckRequest{}) }()
+		go func() { defer wg.Done(); stream.RecvMsg(&healthpb.HealthCheckResponse{}) }()
+	}
+	wg.Wait()
+
+	if entries := logger.Entries(); len(entries) != 1 {
+		t.Errorf("Expected the stream to be logged once, got %d entries", len(entries))
+	}
+}
+
+func TestPayload_MasksUninspectableMessages(t *testing.T) {
+	config := DefaultConfig()
+	config.RedactFields = []string{"password"}
+	adapter := NewGRPCAdapterWithConfig(logtest.New(), config)
+
+	if got := adapter.payload(map[string]string{"password": "secret"}); got != RedactedValue {
+		t.Errorf("payload() = %v, want %q for a non-proto message", got, RedactedValue)
+	}
+	if got := NewGRPCAdapter(logtest.New()).payload("plain"); got != "plain" {
+		t.Errorf("payload() = %v, want the message unchanged without redaction", got)
+	}
+}
+
+func TestInterceptors_SkipMethods(t *testing.T) {
+	serverLogger := logtest.New()
+	config := DefaultConfig()
+	config.SkipMethods = []string{"/grpc.health.v1.Health/*"}
+	client, _ := startServer(t, serverLogger, logtest.New(), config)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "payments"}); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if entries := find(serverLogger, "server"); len(entries) != 0 {
+		t.Errorf("Expected skipped method not to be logged, got %d entries", len(entries))
+	}
+}
+
+// find returns the entries whose grpc.kind field matches kind
+func find(logger *logtest.Logger, kind string) []logtest.Entry {
+	var matched []logtest.Entry
+	for _, entry := range logger.Entries() {
+		if entry.Fields["grpc.kind"] == kind {
+			matched = append(matched, entry)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,115 @@
+package integrations
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// MatchPath reports whether path matches any of the patterns. Patterns ending
+// in "*" match any path with that prefix; other patterns must match exactly.
+func MatchPath(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		} else if path == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// CaptureHeaders returns the values of the allowlisted headers present in h.
+// Header names are matched case-insensitively and reported in canonical form.
+func CaptureHeaders(h http.Header, allowlist []string) map[string]string {
+	if len(allowlist) == 0 {
+		return nil
+	}
+
+	captured := make(map[string]string)
+	for _, name := range allowlist {
+		if values := h.Values(name); len(values) > 0 {
+			captured[http.CanonicalHeaderKey(name)] = strings.Join(values, ", ")
+		}
+	}
+	if len(captured) == 0 {
+		return nil
+	}
+	return captured
+}
+
+// BodyBuffer keeps up to Limit bytes of everything written to it and records
+// whether anything beyond the limit was discarded.
+type BodyBuffer struct {
+	Limit     int
+	Truncated bool
+	buf       bytes.Buffer
+}
+
+// Write implements io.Writer. It never fails, so it is safe to use in an io.TeeReader
+// or alongside a response writer.
+func (b *BodyBuffer) Write(p []byte) (int, error) {
+	remaining := b.Limit - b.buf.Len()
+	if remaining <= 0 {
+		if len(p) > 0 {
+			b.Truncated = true
+		}
+		return len(p), nil
+	}
+	if len(p) > remaining {
+		b.buf.Write(p[:remaining])
+		b.Truncated = true
+		return len(p), nil
+	}
+	b.buf.Write(p)
+	return len(p), nil
+}
+
+// String returns the captured body
+func (b *BodyBuffer) String() string {
+	return b.buf.String()
+}
+
+// CaptureRequestBody reads up to limit bytes of the request body for logging
+// and restores the body so handlers still see the full content.
+func CaptureRequestBody(r *http.Request, limit int) *BodyBuffer {
+	if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	captured, body := CaptureBody(r.Body, limit)
+	r.Body = body
+	return captured
+}
+
+// CaptureBody reads up to limit bytes of body for logging and returns a
+// replacement that yields the full content, including any read error.
+func CaptureBody(body io.ReadCloser, limit int) (*BodyBuffer, io.ReadCloser) {
+	captured := &BodyBuffer{Limit: limit}
+	head, err := io.ReadAll(io.LimitReader(body, int64(limit)+1))
+	captured.Write(head)
+	if err != nil {
+		return captured, readCloser{io.MultiReader(bytes.NewReader(head), errReader{err}), body}
+	}
+	return captured, readCloser{io.MultiReader(bytes.NewReader(head), body), body}
+}
+
+type readCloser struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (rc readCloser) Close() error {
+	return rc.closer.Close()
+}
+
+type errReader struct {
+	err error
+}
+
+func (e errReader) Read([]byte) (int, error) {
+	return 0, e.err
+}
@@ -0,0 +1,61 @@
+package integrations
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMatchPath(t *testing.T) {
+	patterns := []string{"/healthz", "/static/*"}
+	tests := map[string]bool{
+		"/healthz":       true,
+		"/healthz/deep":  false,
+		"/static/app.js": true,
+		"/api/users":     false,
+	}
+	for path, want := range tests {
+		if got := MatchPath(path, patterns); got != want {
+			t.Errorf("MatchPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestCaptureHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	h.Set("Authorization", "Bearer secret")
+	h.Add("X-Forwarded-For", "a")
+	h.Add("X-Forwarded-For", "b")
+
+	captured := CaptureHeaders(h, []string{"content-type", "x-forwarded-for", "X-Missing"})
+	if len(captured) != 2 || captured["Content-Type"] != "application/json" || captured["X-Forwarded-For"] != "a, b" {
+		t.Errorf("Unexpected captured headers: %v", captured)
+	}
+	if CaptureHeaders(h, nil) != nil {
+		t.Error("Expected nil without allowlist")
+	}
+}
+
+func TestCaptureRequestBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789"))
+	captured := CaptureRequestBody(req, 4)
+	if captured.String() != "0123" || !captured.Truncated {
+		t.Errorf("Expected truncated capture, got %q truncated=%v", captured.String(), captured.Truncated)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil || string(body) != "0123456789" {
+		t.Errorf("Expected body to be restored, got %q (%v)", body, err)
+	}
+
+	small := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("abc"))
+	if captured := CaptureRequestBody(small, 4); captured.String() != "abc" || captured.Truncated {
+		t.Errorf("Expected full capture, got %q truncated=%v", captured.String(), captured.Truncated)
+	}
+	if CaptureRequestBody(small, 0) != nil {
+		t.Error("Expected no capture with zero limit")
+	}
+}
@@ -0,0 +1,413 @@
+// Package httpclient logs outbound HTTP calls by wrapping an http.RoundTripper.
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// RedactedValue replaces the values of redacted headers
+const RedactedValue = "[REDACTED]"
+
+// TraceIDHeader is the default header used to propagate trace IDs
+const TraceIDHeader = "X-Trace-ID"
+
+// maxDrainBytes bounds how much of a discarded response is read so the
+// connection can be reused before a retry
+const maxDrainBytes = 4 << 10
+
+// Config holds configuration for the HTTP client transport
+type Config struct {
+	// RequestIDHeader is the header set from the request ID in the request
+	// context (default: X-Request-ID)
+	RequestIDHeader string
+
+	// TraceIDHeader is the header set from the trace ID in the request
+	// context (default: X-Trace-ID)
+	TraceIDHeader string
+
+	// StatusLevels overrides the log level for specific status codes.
+	// Codes without an entry use error for 5xx, warn for 4xx and info otherwise.
+	StatusLevels map[int]core.Level
+
+	// SlowThreshold logs calls slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// HeaderAllowlist lists request and response headers included in the log
+	HeaderAllowlist []string
+
+	// RedactHeaders lists allowlisted headers whose values are replaced with RedactedValue
+	RedactHeaders []string
+
+	// MaxBodyBytes captures up to this many bytes of the request and response
+	// bodies (0 disables body capture)
+	MaxBodyBytes int
+
+	// RedactBody, when set, rewrites captured bodies before they are logged
+	RedactBody func(contentType, body string) string
+
+	// MaxRetries is the number of times a failed call is retried (0 disables retries)
+	MaxRetries int
+
+	// RetryBackoff is the delay before the first retry; it grows linearly with each attempt
+	RetryBackoff time.Duration
+
+	// ShouldRetry reports whether a call should be retried (default: DefaultShouldRetry)
+	ShouldRetry func(req *http.Request, resp *http.Response, err error) bool
+}
+
+// DefaultConfig returns default configuration for the HTTP client transport
+func DefaultConfig() Config {
+	return Config{
+		RequestIDHeader: integrations.RequestIDHeader,
+		TraceIDHeader:   TraceIDHeader,
+		RedactHeaders:   []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"},
+		RetryBackoff:    100 * time.Millisecond,
+		ShouldRetry:     DefaultShouldRetry,
+	}
+}
+
+// DefaultShouldRetry retries idempotent requests that failed with a transport
+// error or with 429, 502, 503 or 504, unless the request context is done
+func DefaultShouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil || !isIdempotent(req) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// HTTPClientAdapter implements integrations.HTTPAdapter for outbound HTTP calls
+type HTTPClientAdapter struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// NewHTTPClientAdapter creates a new HTTP client adapter
+func NewHTTPClientAdapter(coreLogger core.Logger) *HTTPClientAdapter {
+	return NewHTTPClientAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewHTTPClientAdapterWithConfig creates a new HTTP client adapter with configuration
+func NewHTTPClientAdapterWithConfig(coreLogger core.Logger, config Config) *HTTPClientAdapter {
+	if config.RequestIDHeader == "" {
+		config.RequestIDHeader = integrations.RequestIDHeader
+	}
+	if config.TraceIDHeader == "" {
+		config.TraceIDHeader = TraceIDHeader
+	}
+	if config.ShouldRetry == nil {
+		config.ShouldRetry = DefaultShouldRetry
+	}
+
+	return &HTTPClientAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "net/http client", "go1.22+"),
+		config:      config,
+	}
+}
+
+// NewTransport wraps next (http.DefaultTransport when nil) with logging
+func NewTransport(coreLogger core.Logger, config Config, next http.RoundTripper) http.RoundTripper {
+	return NewHTTPClientAdapterWithConfig(coreLogger, config).RoundTripper(next)
+}
+
+// NewClient returns an *http.Client whose calls are logged through coreLogger
+func NewClient(coreLogger core.Logger, config Config) *http.Client {
+	return &http.Client{Transport: NewTransport(coreLogger, config, nil)}
+}
+
+// RoundTripper wraps next (http.DefaultTransport when nil) with logging
+func (h *HTTPClientAdapter) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{adapter: h, next: next}
+}
+
+// LogRequest logs an HTTP request (implements HTTPAdapter interface)
+func (h *HTTPClientAdapter) LogRequest(method, path string, statusCode int, duration int64, userID string) {
+	logFields := []interface{}{
+		"component", "http_client",
+		"operation", "http_request",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"duration_ms", float64(duration) / 1e6,
+	}
+
+	if userID != "" {
+		logFields = append(logFields, "user_id", userID)
+	}
+
+	integrations.LogAtLevel(h.GetLogger(), h.levelForStatus(statusCode), fmt.Sprintf("HTTP %s %s", method, path), logFields...)
+}
+
+// LogMiddleware logs middleware execution (implements HTTPAdapter interface)
+func (h *HTTPClientAdapter) LogMiddleware(middlewareName string, duration int64) {
+	h.GetLogger().Debugw("Middleware executed",
+		"component", "http_client",
+		"operation", "middleware",
+		"middleware_name", middlewareName,
+		"duration_ms", float64(duration)/1e6,
+	)
+}
+
+// LogError logs HTTP-related errors (implements HTTPAdapter interface)
+func (h *HTTPClientAdapter) LogError(err error, method, path string, statusCode int) {
+	h.GetLogger().Errorw("HTTP request failed",
+		"component", "http_client",
+		"operation", "http_error",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+}
+
+func (h *HTTPClientAdapter) levelForStatus(statusCode int) core.Level {
+	if level, ok := h.config.StatusLevels[statusCode]; ok {
+		return level
+	}
+	return integrations.LevelForStatus(statusCode)
+}
+
+// loggerFor returns the request-scoped logger from ctx when there is one,
+// otherwise the adapter logger carrying the trace and request IDs in ctx
+func (h *HTTPClientAdapter) loggerFor(ctx context.Context) core.Logger {
+	if l, ok := integrations.LoggerFromContext(ctx); ok {
+		return l.WithCtx(ctx)
+	}
+
+	var keysAndValues []interface{}
+	if traceID := integrations.TraceIDFromContext(ctx); traceID != "" {
+		keysAndValues = append(keysAndValues, fields.TraceIDField, traceID)
+	}
+	if requestID := integrations.RequestIDFromContext(ctx); requestID != "" {
+		keysAndValues = append(keysAndValues, fields.RequestIDField, requestID)
+	}
+	return h.GetLogger().WithCtx(ctx, keysAndValues...)
+}
+
+// captureHeaders returns the allowlisted headers in h with redacted values replaced
+func (h *HTTPClientAdapter) captureHeaders(header http.Header) map[string]string {
+	captured := integrations.CaptureHeaders(header, h.config.HeaderAllowlist)
+	for _, name := range h.config.RedactHeaders {
+		key := http.CanonicalHeaderKey(name)
+		if _, ok := captured[key]; ok {
+			captured[key] = RedactedValue
+		}
+	}
+	return captured
+}
+
+func (h *HTTPClientAdapter) formatBody(contentType string, body *integrations.BodyBuffer) string {
+	if h.config.RedactBody != nil {
+		return h.config.RedactBody(contentType, body.String())
+	}
+	return body.String()
+}
+
+// transport logs each call made through next, retrying failed calls as configured
+type transport struct {
+	adapter *HTTPClientAdapter
+	next    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	config := t.adapter.config
+	ctx := req.Context()
+	start := time.Now()
+
+	// A RoundTripper must not modify the caller's request
+	req = req.Clone(ctx)
+	if requestID := integrations.RequestIDFromContext(ctx); requestID != "" && req.Header.Get(config.RequestIDHeader) == "" {
+		req.Header.Set(config.RequestIDHeader, requestID)
+	}
+	if traceID := integrations.TraceIDFromContext(ctx); traceID != "" && req.Header.Get(config.TraceIDHeader) == "" {
+		req.Header.Set(config.TraceIDHeader, traceID)
+	}
+
+	var requestBody *integrations.BodyBuffer
+	if config.MaxBodyBytes > 0 && req.Body != nil && req.Body != http.NoBody {
+		requestBody, req.Body = integrations.CaptureBody(req.Body, config.MaxBodyBytes)
+	}
+
+	reqLogger := t.adapter.loggerFor(ctx)
+
+	var (
+		resp    *http.Response
+		err     error
+		retries int
+	)
+	for attempt := 0; ; attempt++ {
+		resp, err = t.next.RoundTrip(req)
+		if attempt >= config.MaxRetries || !canRewind(req) || !config.ShouldRetry(req, resp, err) {
+			break
+		}
+
+		backoff := config.RetryBackoff * time.Duration(attempt+1)
+		t.logRetry(reqLogger, req, attempt+1, backoff, resp, err)
+		if resp != nil {
+			drain(resp.Body)
+		}
+		resp = nil
+
+		if err = sleep(ctx, backoff); err != nil {
+			break
+		}
+		if req, err = rewind(req); err != nil {
+			break
+		}
+		retries++
+	}
+
+	var responseBody *integrations.BodyBuffer
+	if err == nil && config.MaxBodyBytes > 0 && resp.Body != nil && resp.Body != http.NoBody {
+		responseBody, resp.Body = integrations.CaptureBody(resp.Body, config.MaxBodyBytes)
+	}
+
+	t.logCall(reqLogger, req, resp, err, time.Since(start), retries, requestBody, responseBody)
+	return resp, err
+}
+
+func (t *transport) logCall(reqLogger core.Logger, req *http.Request, resp *http.Response, err error, latency time.Duration, retries int, requestBody, responseBody *integrations.BodyBuffer) {
+	config := t.adapter.config
+
+	logFields := []interface{}{
+		"component", "http_client",
+		"method", req.Method,
+		"host", req.URL.Host,
+		"path", req.URL.Path,
+		"latency_ms", float64(latency.Nanoseconds()) / 1e6,
+		"retries", retries,
+	}
+
+	level := core.ErrorLevel
+	if err != nil {
+		logFields = append(logFields, fields.ErrorField, err)
+	} else {
+		logFields = append(logFields, "status_code", resp.StatusCode)
+		level = t.adapter.levelForStatus(resp.StatusCode)
+	}
+
+	if headers := t.adapter.captureHeaders(req.Header); headers != nil {
+		logFields = append(logFields, "request_headers", headers)
+	}
+	if resp != nil {
+		if headers := t.adapter.captureHeaders(resp.Header); headers != nil {
+			logFields = append(logFields, "response_headers", headers)
+		}
+	}
+	if requestBody != nil {
+		logFields = append(logFields, "request_body", t.adapter.formatBody(req.Header.Get("Content-Type"), requestBody))
+		if requestBody.Truncated {
+			logFields = append(logFields, "request_body_truncated", true)
+		}
+	}
+	if responseBody != nil {
+		logFields = append(logFields, "response_body", t.adapter.formatBody(resp.Header.Get("Content-Type"), responseBody))
+		if responseBody.Truncated {
+			logFields = append(logFields, "response_body_truncated", true)
+		}
+	}
+
+	if config.SlowThreshold > 0 && latency > config.SlowThreshold {
+		logFields = append(logFields, "slow_request", true)
+		if level < core.WarnLevel {
+			level = core.WarnLevel
+		}
+	}
+
+	integrations.LogAtLevel(reqLogger, level, fmt.Sprintf("HTTP %s %s%s", req.Method, req.URL.Host, req.URL.Path), logFields...)
+}
+
+func (t *transport) logRetry(reqLogger core.Logger, req *http.Request, attempt int, backoff time.Duration, resp *http.Response, err error) {
+	logFields := []interface{}{
+		"component", "http_client",
+		"method", req.Method,
+		"host", req.URL.Host,
+		"path", req.URL.Path,
+		"attempt", attempt,
+		"backoff_ms", float64(backoff.Nanoseconds()) / 1e6,
+	}
+	if err != nil {
+		logFields = append(logFields, fields.ErrorField, err)
+	} else {
+		logFields = append(logFields, "status_code", resp.StatusCode)
+	}
+
+	reqLogger.Warnw("Retrying HTTP request", logFields...)
+}
+
+// sleep waits for d or until ctx is done, returning the context error in that case
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// canRewind reports whether the request body can be sent again
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of req with a fresh body for another attempt
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return req, errors.New("httpclient: request body cannot be replayed")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return req, err
+	}
+	retry := req.Clone(req.Context())
+	retry.Body = body
+	return retry, nil
+}
+
+// isIdempotent reports whether req may safely be sent more than once
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	_, hasKey := req.Header["Idempotency-Key"]
+	_, hasXKey := req.Header["X-Idempotency-Key"]
+	return hasKey || hasXKey
+}
+
+// drain reads a bounded amount of a discarded body and closes it
+func drain(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, maxDrainBytes))
+	_ = body.Close()
+}
+
+// Verify that HTTPClientAdapter implements the HTTPAdapter interface
+var _ integrations.HTTPAdapter = (*HTTPClientAdapter)(nil)
@@ -0,0 +1,263 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+func TestHTTPClientAdapter_ImplementsInterface(t *testing.T) {
+	var _ integrations.HTTPAdapter = NewHTTPClientAdapter(logtest.New())
+}
+
+func TestTransport_LogsCall(t *testing.T) {
+	var gotRequestID, gotTraceID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = r.Header.Get("X-Request-ID")
+		gotTraceID = r.Header.Get("X-Trace-ID")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	recorder := logtest.New()
+	client := NewClient(recorder, DefaultConfig())
+
+	ctx := integrations.ContextWithRequestID(context.Background(), "req-1")
+	ctx = integrations.ContextWithTraceID(ctx, "trace-1")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/orders?token=secret", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if gotRequestID != "req-1" || gotTraceID != "trace-1" {
+		t.Errorf("Expected propagated IDs, got %q and %q", gotRequestID, gotTraceID)
+	}
+	if req.Header.Get("X-Request-ID") != "" {
+		t.Error("Expected the caller's request to be left unmodified")
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	host := strings.TrimPrefix(server.URL, "http://")
+	if entry.Level != core.InfoLevel || entry.Msg != "HTTP POST "+host+"/v1/orders" {
+		t.Errorf("Unexpected entry %v %q", entry.Level, entry.Msg)
+	}
+	expected := map[string]interface{}{
+		"component":   "http_client",
+		"method":      "POST",
+		"host":        host,
+		"path":        "/v1/orders",
+		"status_code": http.StatusCreated,
+		"retries":     0,
+		"request_id":  "req-1",
+		"trace_id":    "trace-1",
+	}
+	for key, want := range expected {
+		if entry.Fields[key] != want {
+			t.Errorf("Field %s = %v, want %v", key, entry.Fields[key], want)
+		}
+	}
+	if _, ok := entry.Fields["latency_ms"]; !ok {
+		t.Error("Expected latency_ms field")
+	}
+}
+
+func TestTransport_StatusLevelsAndErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := logtest.New()
+	client := NewClient(recorder, DefaultConfig())
+
+	resp, err := client.Get(server.URL + "/missing")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	failing := &http.Client{Transport: NewTransport(recorder, DefaultConfig(), roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("dial tcp: connection refused")
+	}))}
+	if _, err := failing.Post("http://partner.example/v1/orders", "application/json", nil); err == nil {
+		t.Fatal("Expected transport error")
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Level != core.WarnLevel {
+		t.Errorf("Expected warn for 404, got %v", entries[0].Level)
+	}
+	if entries[1].Level != core.ErrorLevel || !isError(entries[1].Fields["error"], "dial tcp: connection refused") {
+		t.Errorf("Unexpected error entry %+v", entries[1])
+	}
+	if _, ok := entries[1].Fields["status_code"]; ok {
+		t.Error("Expected no status_code without a response")
+	}
+}
+
+func TestTransport_CapturesHeadersAndBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.Write([]byte(`{"echo":` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.HeaderAllowlist = []string{"Content-Type", "Authorization", "Set-Cookie"}
+	config.MaxBodyBytes = 16
+	config.RedactBody = func(contentType, body string) string {
+		return strings.ReplaceAll(body, "hunter2", RedactedValue)
+	}
+	client := NewClient(recorder, config)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/login", strings.NewReader(`"hunter2"`))
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"echo":"hunter2"}` {
+		t.Errorf("Expected the full response body, got %q", body)
+	}
+
+	entry := recorder.Entries()[0]
+	requestHeaders, _ := entry.Fields["request_headers"].(map[string]string)
+	if requestHeaders["Authorization"] != RedactedValue || requestHeaders["Content-Type"] != "application/json" {
+		t.Errorf("Unexpected request headers %v", requestHeaders)
+	}
+	responseHeaders, _ := entry.Fields["response_headers"].(map[string]string)
+	if responseHeaders["Set-Cookie"] != RedactedValue {
+		t.Errorf("Expected Set-Cookie to be redacted, got %v", responseHeaders)
+	}
+	if entry.Fields["request_body"] != `"`+RedactedValue+`"` {
+		t.Errorf("Unexpected request body %v", entry.Fields["request_body"])
+	}
+	if entry.Fields["response_body"] != `{"echo":"`+RedactedValue || entry.Fields["response_body_truncated"] != true {
+		t.Errorf("Expected truncated, redacted response body, got %q", entry.Fields["response_body"])
+	}
+}
+
+func TestTransport_Retries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected replayed body, got %q", body)
+		}
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.MaxRetries = 3
+	config.RetryBackoff = time.Millisecond
+	client := NewClient(recorder, config)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/items/1", strings.NewReader("payload"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	entries := recorder.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("Expected 2 retry entries and 1 call entry, got %d", len(entries))
+	}
+	for i, entry := range entries[:2] {
+		if entry.Level != core.WarnLevel || entry.Fields["attempt"] != i+1 || entry.Fields["status_code"] != http.StatusServiceUnavailable {
+			t.Errorf("Unexpected retry entry %+v", entry)
+		}
+	}
+	if entries[2].Fields["retries"] != 2 || entries[2].Fields["status_code"] != http.StatusOK {
+		t.Errorf("Unexpected call entry %+v", entries[2])
+	}
+}
+
+func TestTransport_NoRetryForNonIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := DefaultConfig()
+	config.MaxRetries = 3
+	config.RetryBackoff = time.Millisecond
+	client := NewClient(logtest.New(), config)
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("x"))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected POST not to be retried, got %d calls", calls.Load())
+	}
+}
+
+func TestTransport_SlowThreshold(t *testing.T) {
+	recorder := logtest.New()
+	config := DefaultConfig()
+	config.SlowThreshold = time.Nanosecond
+	client := &http.Client{Transport: NewTransport(recorder, config, roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		time.Sleep(time.Millisecond)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody, Request: r}, nil
+	}))}
+
+	resp, err := client.Get("http://partner.example/slow")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	entry := recorder.Entries()[0]
+	if entry.Level != core.WarnLevel || entry.Fields["slow_request"] != true {
+		t.Errorf("Expected slow request warning, got %+v", entry)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// isError reports whether v is an error with message msg, so the engines
+// expand it into the error_type and error_causes fields
+func isError(v interface{}, msg string) bool {
+	err, ok := v.(error)
+	return ok && err.Error() == msg
+}
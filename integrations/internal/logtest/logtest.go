@@ -0,0 +1,117 @@
+// Package logtest provides a core.Logger that records structured log calls
+// for the integration tests.
+package logtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+)
+
+// Entry is a recorded log call.
+type Entry struct {
+	Level  core.Level
+	Msg    string
+	Fields map[string]interface{}
+}
+
+// Logger records the structured and typed-field log calls made through it
+// and the loggers derived from it with With, including the fields added by
+// With. Unstructured calls are ignored.
+type Logger struct {
+	mu      *sync.Mutex
+	entries *[]Entry
+	fields  []interface{}
+}
+
+// New returns an empty recording logger.
+func New() *Logger {
+	return &Logger{mu: &sync.Mutex{}, entries: &[]Entry{}}
+}
+
+// Entries returns the recorded entries in order.
+func (l *Logger) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Entry{}, *l.entries...)
+}
+
+// Reset discards the recorded entries.
+func (l *Logger) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	*l.entries = nil
+}
+
+func (l *Logger) record(level core.Level, msg string, keysAndValues ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	all := append(append([]interface{}{}, l.fields...), keysAndValues...)
+	recorded := make(map[string]interface{})
+	for i := 0; i+1 < len(all); i += 2 {
+		if key, ok := all[i].(string); ok {
+			recorded[key] = all[i+1]
+		}
+	}
+	*l.entries = append(*l.entries, Entry{Level: level, Msg: msg, Fields: recorded})
+}
+
+func (l *Logger) recordFields(level core.Level, msg string, fs []fields.Field) {
+	keysAndValues := make([]interface{}, 0, 2*len(fs))
+	for _, f := range fs {
+		keysAndValues = append(keysAndValues, f.Key, f.Value())
+	}
+	l.record(level, msg, keysAndValues...)
+}
+
+func (l *Logger) Debug(args ...interface{})                   {}
+func (l *Logger) Info(args ...interface{})                    {}
+func (l *Logger) Warn(args ...interface{})                    {}
+func (l *Logger) Error(args ...interface{})                   {}
+func (l *Logger) Fatal(args ...interface{})                   {}
+func (l *Logger) Debugf(template string, args ...interface{}) {}
+func (l *Logger) Infof(template string, args ...interface{})  {}
+func (l *Logger) Warnf(template string, args ...interface{})  {}
+func (l *Logger) Errorf(template string, args ...interface{}) {}
+func (l *Logger) Fatalf(template string, args ...interface{}) {}
+
+func (l *Logger) Debugw(msg string, keysAndValues ...interface{}) {
+	l.record(core.DebugLevel, msg, keysAndValues...)
+}
+
+func (l *Logger) Infow(msg string, keysAndValues ...interface{}) {
+	l.record(core.InfoLevel, msg, keysAndValues...)
+}
+
+func (l *Logger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.record(core.WarnLevel, msg, keysAndValues...)
+}
+
+func (l *Logger) Errorw(msg string, keysAndValues ...interface{}) {
+	l.record(core.ErrorLevel, msg, keysAndValues...)
+}
+
+func (l *Logger) Fatalw(msg string, keysAndValues ...interface{}) {
+	l.record(core.FatalLevel, msg, keysAndValues...)
+}
+
+func (l *Logger) DebugF(msg string, fs ...fields.Field) { l.recordFields(core.DebugLevel, msg, fs) }
+func (l *Logger) InfoF(msg string, fs ...fields.Field)  { l.recordFields(core.InfoLevel, msg, fs) }
+func (l *Logger) WarnF(msg string, fs ...fields.Field)  { l.recordFields(core.WarnLevel, msg, fs) }
+func (l *Logger) ErrorF(msg string, fs ...fields.Field) { l.recordFields(core.ErrorLevel, msg, fs) }
+func (l *Logger) FatalF(msg string, fs ...fields.Field) { l.recordFields(core.FatalLevel, msg, fs) }
+
+func (l *Logger) With(keysAndValues ...interface{}) core.Logger {
+	return &Logger{mu: l.mu, entries: l.entries, fields: append(append([]interface{}{}, l.fields...), keysAndValues...)}
+}
+
+func (l *Logger) WithCtx(ctx context.Context, keysAndValues ...interface{}) core.Logger {
+	return l.With(keysAndValues...)
+}
+
+func (l *Logger) WithCallerSkip(skip int) core.Logger { return l }
+func (l *Logger) WithGroup(name string) core.Logger   { return l }
+func (l *Logger) Named(name string) core.Logger       { return l }
+func (l *Logger) SetLevel(level core.Level)           {}
@@ -151,10 +151,9 @@ func (k *KratosAdapter) Log(level Level, keyvals ...interface{}) error {
 		msg = "Kratos log message"
 	}
 
-	// Add kratos-specific fields
+	// Add kratos-specific fields; the level is emitted by the core logger itself
 	fieldsWithMeta := append([]interface{}{
 		"component", "kratos",
-		"level", level.String(),
 	}, allKeyvals...)
 
 	// Map Kratos levels to our core levels and log
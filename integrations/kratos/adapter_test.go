@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 )
 
 // Mock logger for testing Kratos adapter
@@ -53,6 +54,11 @@ func (m *mockLogger) Errorw(msg string, keysAndValues ...interface{}) {
 func (m *mockLogger) Fatalw(msg string, keysAndValues ...interface{}) {
 	m.fatalCalls = append(m.fatalCalls, mockCall{msg: msg, fields: keysAndValues})
 }
+func (m *mockLogger) DebugF(msg string, fs ...fields.Field) {}
+func (m *mockLogger) InfoF(msg string, fs ...fields.Field)  {}
+func (m *mockLogger) WarnF(msg string, fs ...fields.Field)  {}
+func (m *mockLogger) ErrorF(msg string, fs ...fields.Field) {}
+func (m *mockLogger) FatalF(msg string, fs ...fields.Field) {}
 
 func (m *mockLogger) With(keysAndValues ...interface{}) core.Logger {
 	return m
@@ -66,6 +72,14 @@ func (m *mockLogger) WithCallerSkip(skip int) core.Logger {
 	return m
 }
 
+func (m *mockLogger) WithGroup(name string) core.Logger {
+	return m
+}
+
+func (m *mockLogger) Named(name string) core.Logger {
+	return m
+}
+
 func TestNewKratosAdapter(t *testing.T) {
 	mockLog := &mockLogger{}
 	adapter := NewKratosAdapter(mockLog)
@@ -0,0 +1,12 @@
+module github.com/kart-io/logger/integrations/kratos/kratoslogger
+
+go 1.25.0
+
+replace github.com/kart-io/logger => ../../..
+
+require (
+	github.com/go-kratos/kratos/v2 v2.8.4
+	github.com/kart-io/logger v0.0.0
+)
+
+require golang.org/x/sync v0.14.0 // indirect
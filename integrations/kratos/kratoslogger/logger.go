@@ -0,0 +1,144 @@
+// Package kratoslogger adapts the unified logger to Kratos' log.Logger.
+//
+// It lives in its own module so that only projects using Kratos depend on it.
+package kratoslogger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+)
+
+// UnpairedValue is appended to keyvals with an odd number of elements, matching Kratos' std logger
+const UnpairedValue = "KEYVALS UNPAIRED"
+
+// FatalField marks entries logged at log.LevelFatal, which are written at
+// error level because the adapter never exits
+const FatalField = "fatal"
+
+// ErrUnpairedKeyvals is returned by Log for keyvals with an odd number of
+// elements. The entry is still written, padded with UnpairedValue.
+var ErrUnpairedKeyvals = errors.New("kratoslogger: keyvals unpaired")
+
+// Config holds configuration for the Kratos logger
+type Config struct {
+	// MessageKey is the key whose value becomes the log message (default: log.DefaultMessageKey)
+	MessageKey string
+
+	// Context is passed to Valuer keyvals that reach the logger unresolved
+	// and to core.Logger.WithCtx (default: context.Background())
+	Context context.Context
+}
+
+// DefaultConfig returns default configuration for the Kratos logger
+func DefaultConfig() Config {
+	return Config{
+		MessageKey: log.DefaultMessageKey,
+		Context:    context.Background(),
+	}
+}
+
+// Logger implements github.com/go-kratos/kratos/v2/log.Logger using the unified logger
+type Logger struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// New creates a Kratos logger with the default configuration
+func New(coreLogger core.Logger) *Logger {
+	return NewWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewWithConfig creates a Kratos logger with configuration
+func NewWithConfig(coreLogger core.Logger, config Config) *Logger {
+	if config.MessageKey == "" {
+		config.MessageKey = log.DefaultMessageKey
+	}
+	if config.Context == nil {
+		config.Context = context.Background()
+	}
+
+	return &Logger{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "Kratos", "v2.x"),
+		config:      config,
+	}
+}
+
+// NewFilter creates a Kratos filter around a new logger. Kratos filter
+// options such as log.FilterLevel, log.FilterKey, log.FilterValue and
+// log.FilterFunc apply before entries reach the unified logger.
+func NewFilter(coreLogger core.Logger, opts ...log.FilterOption) *log.Filter {
+	return log.NewFilter(New(coreLogger), opts...)
+}
+
+// NewHelper creates a Kratos helper around a new, optionally filtered, logger
+func NewHelper(coreLogger core.Logger, opts ...log.FilterOption) *log.Helper {
+	if len(opts) == 0 {
+		return log.NewHelper(New(coreLogger))
+	}
+	return log.NewHelper(NewFilter(coreLogger, opts...))
+}
+
+// WithContext returns a copy of the logger that resolves Valuers with ctx
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	newLogger := *l
+	newLogger.config.Context = ctx
+	return &newLogger
+}
+
+// Log implements Kratos' log.Logger interface
+func (l *Logger) Log(level log.Level, keyvals ...interface{}) error {
+	if len(keyvals) == 0 {
+		return nil
+	}
+	var err error
+	if len(keyvals)%2 != 0 {
+		keyvals = append(keyvals, UnpairedValue)
+		err = ErrUnpairedKeyvals
+	}
+
+	msg := ""
+	logFields := make([]interface{}, 0, len(keyvals)+4)
+	logFields = append(logFields, "component", "kratos")
+	if level == log.LevelFatal {
+		logFields = append(logFields, FatalField, true)
+	}
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		value := log.Value(l.config.Context, keyvals[i+1])
+		if key == l.config.MessageKey && msg == "" {
+			msg = fmt.Sprint(value)
+			continue
+		}
+		logFields = append(logFields, key, value)
+	}
+
+	integrations.LogAtLevel(l.GetLogger().WithCtx(l.config.Context), coreLevel(level), msg, logFields...)
+	return err
+}
+
+// coreLevel maps Kratos levels to core levels. Fatal entries are logged at
+// error level with FatalField set, without exiting; log.Helper.Fatal exits
+// on its own after logging.
+func coreLevel(level log.Level) core.Level {
+	switch level {
+	case log.LevelDebug:
+		return core.DebugLevel
+	case log.LevelWarn:
+		return core.WarnLevel
+	case log.LevelError:
+		return core.ErrorLevel
+	case log.LevelFatal:
+		return core.FatalLevel
+	default:
+		return core.InfoLevel
+	}
+}
+
+// Verify that Logger implements Kratos' logger interface
+var _ log.Logger = (*Logger)(nil)
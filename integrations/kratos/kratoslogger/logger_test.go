@@ -0,0 +1,126 @@
+package kratoslogger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+type traceKey struct{}
+
+func traceID() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		id, _ := ctx.Value(traceKey{}).(string)
+		return id
+	}
+}
+
+func TestLogger_LevelsAndMessage(t *testing.T) {
+	recorder := logtest.New()
+	helper := log.NewHelper(New(recorder))
+
+	helper.Debugw("msg", "debugging", "step", 1)
+	helper.Infof("hello %s", "world")
+	helper.Warn("careful")
+	helper.Errorw("msg", "failed", "error", "boom")
+	_ = New(recorder).Log(log.LevelFatal, "msg", "fatal without exit")
+
+	entries := recorder.Entries()
+	expected := []struct {
+		level core.Level
+		msg   string
+	}{
+		{core.DebugLevel, "debugging"},
+		{core.InfoLevel, "hello world"},
+		{core.WarnLevel, "careful"},
+		{core.ErrorLevel, "failed"},
+		{core.ErrorLevel, "fatal without exit"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, want := range expected {
+		if entries[i].Level != want.level || entries[i].Msg != want.msg {
+			t.Errorf("Entry %d = %s %q, want %s %q", i, entries[i].Level, entries[i].Msg, want.level, want.msg)
+		}
+		if _, ok := entries[i].Fields["msg"]; ok {
+			t.Errorf("Entry %d should not repeat the message as a field", i)
+		}
+		if _, ok := entries[i].Fields["level"]; ok {
+			t.Errorf("Entry %d should not carry a second level field", i)
+		}
+	}
+	if entries[0].Fields["step"] != 1 || entries[3].Fields["error"] != "boom" {
+		t.Errorf("Expected keyvals to become fields, got %v and %v", entries[0].Fields, entries[3].Fields)
+	}
+	if _, ok := entries[3].Fields[FatalField]; ok {
+		t.Errorf("Expected only fatal entries to be marked, got %v", entries[3].Fields)
+	}
+	if entries[4].Fields[FatalField] != true {
+		t.Errorf("Expected fatal entry to be marked, got %v", entries[4].Fields)
+	}
+}
+
+func TestLogger_ResolvesValuers(t *testing.T) {
+	recorder := logtest.New()
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+
+	// Valuers bound through log.With are resolved by Kratos with the logger context
+	logger := log.With(New(recorder), "caller", log.DefaultCaller, "trace_id", traceID())
+	log.NewHelper(log.WithContext(ctx, logger)).Info("via kratos")
+
+	// Valuers passed straight to the adapter are resolved with its own context
+	_ = New(recorder).WithContext(ctx).Log(log.LevelInfo, "msg", "direct", "trace_id", traceID())
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.Fields["trace_id"] != "trace-1" {
+			t.Errorf("Expected resolved trace_id for %q, got %v", entry.Msg, entry.Fields["trace_id"])
+		}
+	}
+	if caller, _ := entries[0].Fields["caller"].(string); !strings.HasPrefix(caller, "kratoslogger/logger_test.go:") {
+		t.Errorf("Expected caller in test file, got %q", caller)
+	}
+}
+
+func TestLogger_FilterOptions(t *testing.T) {
+	recorder := logtest.New()
+	helper := NewHelper(recorder,
+		log.FilterLevel(log.LevelInfo),
+		log.FilterKey("password"),
+		log.FilterValue("4111111111111111"),
+	)
+
+	helper.Debug("dropped")
+	helper.Infow("msg", "login", "user", "alice", "password", "hunter2", "card", "4111111111111111")
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected debug entry to be filtered, got %d entries", len(entries))
+	}
+	fields := entries[0].Fields
+	if fields["password"] != "***" || fields["card"] != "***" || fields["user"] != "alice" {
+		t.Errorf("Expected masked key and value, got %v", fields)
+	}
+}
+
+func TestLogger_UnpairedKeyvals(t *testing.T) {
+	recorder := logtest.New()
+	if err := New(recorder).Log(log.LevelInfo, "msg", "odd", "orphan"); !errors.Is(err, ErrUnpairedKeyvals) {
+		t.Errorf("Expected ErrUnpairedKeyvals, got %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 || entries[0].Fields["orphan"] != UnpairedValue {
+		t.Errorf("Expected unpaired key to be padded, got %+v", entries)
+	}
+}
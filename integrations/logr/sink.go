@@ -0,0 +1,116 @@
+// Package logr implements a github.com/go-logr/logr LogSink on top of core.Logger
+// for code such as controller-runtime that requires a logr.Logger.
+package logr
+
+import (
+	"github.com/go-logr/logr"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+)
+
+// Config holds configuration for the logr sink
+type Config struct {
+	// Verbosity is the highest V-level that is enabled
+	Verbosity int
+
+	// LevelForVerbosity maps a V-level to the core level it is logged at
+	// (default: DefaultLevelForVerbosity)
+	LevelForVerbosity func(v int) core.Level
+}
+
+// DefaultConfig returns default configuration for the logr sink
+func DefaultConfig() Config {
+	return Config{
+		Verbosity:         1,
+		LevelForVerbosity: DefaultLevelForVerbosity,
+	}
+}
+
+// DefaultLevelForVerbosity logs V(0) at info level and every higher V-level at debug level
+func DefaultLevelForVerbosity(v int) core.Level {
+	if v <= 0 {
+		return core.InfoLevel
+	}
+	return core.DebugLevel
+}
+
+// LogSink implements logr.LogSink and logr.CallDepthLogSink using the unified logger
+type LogSink struct {
+	logger core.Logger
+	config Config
+}
+
+// NewLogger returns a logr.Logger backed by logger with the default configuration
+func NewLogger(logger core.Logger) logr.Logger {
+	return logr.New(NewLogSink(logger, DefaultConfig()))
+}
+
+// NewLoggerWithConfig returns a logr.Logger backed by logger with configuration
+func NewLoggerWithConfig(logger core.Logger, config Config) logr.Logger {
+	return logr.New(NewLogSink(logger, config))
+}
+
+// NewLogSink creates a logr.LogSink backed by logger
+func NewLogSink(logger core.Logger, config Config) *LogSink {
+	if config.LevelForVerbosity == nil {
+		config.LevelForVerbosity = DefaultLevelForVerbosity
+	}
+	return &LogSink{logger: logger, config: config}
+}
+
+// Init skips the sink method and the logr.Logger frames so the reported
+// caller is the code that logged
+func (s *LogSink) Init(info logr.RuntimeInfo) {
+	s.logger = s.logger.WithCallerSkip(info.CallDepth + 1)
+}
+
+// Enabled reports whether the V-level is at or below the configured verbosity
+func (s *LogSink) Enabled(level int) bool {
+	return level <= s.config.Verbosity
+}
+
+// Info logs a non-error message at the core level mapped from the V-level
+func (s *LogSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	switch s.config.LevelForVerbosity(level) {
+	case core.DebugLevel:
+		s.logger.Debugw(msg, keysAndValues...)
+	case core.WarnLevel:
+		s.logger.Warnw(msg, keysAndValues...)
+	case core.ErrorLevel, core.FatalLevel:
+		s.logger.Errorw(msg, keysAndValues...)
+	default:
+		s.logger.Infow(msg, keysAndValues...)
+	}
+}
+
+// Error logs an error at error level with the error field; the engines
+// attach a stacktrace to error-level entries
+func (s *LogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	if err != nil {
+		keysAndValues = append([]interface{}{fields.ErrorField, err}, keysAndValues...)
+	}
+	s.logger.Errorw(msg, keysAndValues...)
+}
+
+// WithValues returns a sink that adds keysAndValues to every entry
+func (s *LogSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &LogSink{logger: s.logger.With(keysAndValues...), config: s.config}
+}
+
+// WithName returns a sink whose name is joined to the current one with a dot
+// and reported in the logger field
+func (s *LogSink) WithName(name string) logr.LogSink {
+	return &LogSink{logger: s.logger.Named(name), config: s.config}
+}
+
+// WithCallDepth returns a sink that skips depth additional stack frames
+func (s *LogSink) WithCallDepth(depth int) logr.LogSink {
+	return &LogSink{logger: s.logger.WithCallerSkip(depth), config: s.config}
+}
+
+// Verify that LogSink implements logr's sink interfaces
+var (
+	_ logr.LogSink          = (*LogSink)(nil)
+	_ logr.CallDepthLogSink = (*LogSink)(nil)
+)
@@ -0,0 +1,112 @@
+package logr
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/option"
+)
+
+func readEntries(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Failed to parse %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func message(entry map[string]interface{}) interface{} {
+	if msg, ok := entry["message"]; ok {
+		return msg
+	}
+	return entry["msg"]
+}
+
+func TestDefaultLevelForVerbosity(t *testing.T) {
+	if DefaultLevelForVerbosity(0) != core.InfoLevel || DefaultLevelForVerbosity(3) != core.DebugLevel {
+		t.Error("Expected V(0) at info and higher V-levels at debug")
+	}
+}
+
+func TestLogSink_Engines(t *testing.T) {
+	for _, engine := range []string{"zap", "slog"} {
+		t.Run(engine, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.Level = "debug"
+			opt.OutputPaths = []string{logFile}
+
+			coreLogger, err := logger.New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			log := NewLogger(coreLogger).WithName("controller").WithName("reconciler").WithValues("namespace", "default")
+			log.Info("reconciling", "name", "web")
+			log.V(1).Info("detail")
+			log.V(2).Info("too verbose")
+			log.Error(errors.New("conflict"), "update failed", "attempt", 2)
+
+			entries := readEntries(t, logFile)
+			if len(entries) != 3 {
+				t.Fatalf("Expected V(2) to be disabled and 3 entries written, got %d", len(entries))
+			}
+
+			expected := []struct {
+				level string
+				msg   string
+			}{
+				{"info", "reconciling"},
+				{"debug", "detail"},
+				{"error", "update failed"},
+			}
+			for i, want := range expected {
+				entry := entries[i]
+				if entry["level"] != want.level || message(entry) != want.msg {
+					t.Errorf("Entry %d = %v %v, want %s %s", i, entry["level"], message(entry), want.level, want.msg)
+				}
+				if entry["logger"] != "controller.reconciler" || entry["namespace"] != "default" {
+					t.Errorf("Entry %d missing name or values: %v", i, entry)
+				}
+				if caller, _ := entry["caller"].(string); !strings.Contains(caller, "sink_test.go") {
+					t.Errorf("Entry %d caller = %q, want test file", i, caller)
+				}
+			}
+
+			errEntry := entries[2]
+			if errEntry["error"] != "conflict" || errEntry["error_type"] != "*errors.errorString" || errEntry["attempt"] != float64(2) {
+				t.Errorf("Expected expanded error fields and values, got %v", errEntry)
+			}
+			if stack, _ := errEntry["stacktrace"].(string); !strings.Contains(stack, "sink_test.go") {
+				t.Errorf("Expected stacktrace through the test, got %q", stack)
+			}
+		})
+	}
+}
+
+func TestLogSink_Verbosity(t *testing.T) {
+	config := DefaultConfig()
+	config.Verbosity = 3
+	sink := NewLogSink(logger.Global(), config)
+
+	if !sink.Enabled(3) || sink.Enabled(4) {
+		t.Error("Expected configured verbosity to bound enabled V-levels")
+	}
+}
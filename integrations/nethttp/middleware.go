@@ -0,0 +1,278 @@
+package nethttp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// Config holds configuration for the net/http middleware
+type Config struct {
+	// SkipPaths are request paths that are served without being logged.
+	// Entries ending in "*" match any path with that prefix.
+	SkipPaths []string
+
+	// RequestIDHeader is the header read and written for request IDs (default: X-Request-ID)
+	RequestIDHeader string
+
+	// GenerateRequestID creates a request ID when the request carries none
+	GenerateRequestID func() string
+
+	// StatusLevels overrides the log level for specific status codes.
+	// Codes without an entry use error for 5xx, warn for 4xx and info otherwise.
+	StatusLevels map[int]core.Level
+
+	// SlowThreshold logs requests slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// DisableRecovery lets panics propagate instead of recovering them
+	DisableRecovery bool
+}
+
+// DefaultConfig returns default configuration for the net/http middleware
+func DefaultConfig() Config {
+	return Config{
+		RequestIDHeader:   integrations.RequestIDHeader,
+		GenerateRequestID: integrations.NewRequestID,
+	}
+}
+
+// HTTPAdapter implements integrations.HTTPAdapter for the standard library net/http server
+type HTTPAdapter struct {
+	*integrations.BaseAdapter
+	config Config
+}
+
+// NewHTTPAdapter creates a new net/http adapter
+func NewHTTPAdapter(coreLogger core.Logger) *HTTPAdapter {
+	return NewHTTPAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewHTTPAdapterWithConfig creates a new net/http adapter with configuration
+func NewHTTPAdapterWithConfig(coreLogger core.Logger, config Config) *HTTPAdapter {
+	if config.RequestIDHeader == "" {
+		config.RequestIDHeader = integrations.RequestIDHeader
+	}
+	if config.GenerateRequestID == nil {
+		config.GenerateRequestID = integrations.NewRequestID
+	}
+
+	return &HTTPAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "net/http", "go1.23+"),
+		config:      config,
+	}
+}
+
+// Middleware returns http.Handler middleware that logs every request using the given logger
+func Middleware(coreLogger core.Logger, config Config) func(http.Handler) http.Handler {
+	return NewHTTPAdapterWithConfig(coreLogger, config).Middleware
+}
+
+// FromContext returns the request-scoped logger attached by the middleware,
+// or the global logger when the context carries none
+func FromContext(ctx context.Context) core.Logger {
+	if l, ok := integrations.LoggerFromContext(ctx); ok {
+		return l
+	}
+	return logger.Global()
+}
+
+// Middleware wraps next with request logging, request ID propagation,
+// a request-scoped logger in the request context and panic recovery
+func (h *HTTPAdapter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if integrations.MatchPath(r.URL.Path, h.config.SkipPaths) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+
+		requestID := r.Header.Get(h.config.RequestIDHeader)
+		if requestID == "" {
+			requestID = h.config.GenerateRequestID()
+		}
+		w.Header().Set(h.config.RequestIDHeader, requestID)
+
+		reqLogger := h.GetLogger().With(fields.RequestIDField, requestID)
+		ctx := integrations.ContextWithRequestID(r.Context(), requestID)
+		ctx = integrations.ContextWithLogger(ctx, reqLogger)
+		r = r.WithContext(ctx)
+
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+		completed := false
+		defer func() {
+			panicked := !completed
+			if !h.config.DisableRecovery {
+				if rec := recover(); rec != nil {
+					// http.ErrAbortHandler deliberately aborts the response
+					// and is left for the server to handle
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+					reqLogger.Errorw("Panic recovered",
+						"component", "net/http",
+						"method", r.Method,
+						"path", r.URL.Path,
+						"error", fmt.Sprint(rec),
+						"panic", true,
+						"panic_stack", string(debug.Stack()),
+					)
+					if !rw.wroteHeader {
+						http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					}
+				}
+			}
+			if panicked {
+				// The response failed whether or not the panic was recovered
+				rw.status = http.StatusInternalServerError
+			}
+
+			h.logRequest(reqLogger, r, rw, time.Since(start), panicked)
+		}()
+
+		next.ServeHTTP(rw, r)
+		completed = true
+	})
+}
+
+// LogRequest logs an HTTP request (implements HTTPAdapter interface)
+func (h *HTTPAdapter) LogRequest(method, path string, statusCode int, duration int64, userID string) {
+	logFields := []interface{}{
+		"component", "net/http",
+		"operation", "http_request",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"duration_ms", float64(duration) / 1e6,
+	}
+
+	if userID != "" {
+		logFields = append(logFields, "user_id", userID)
+	}
+
+	integrations.LogAtLevel(h.GetLogger(), h.levelForStatus(statusCode), fmt.Sprintf("HTTP %s %s", method, path), logFields...)
+}
+
+// LogMiddleware logs middleware execution (implements HTTPAdapter interface)
+func (h *HTTPAdapter) LogMiddleware(middlewareName string, duration int64) {
+	h.GetLogger().Debugw("Middleware executed",
+		"component", "net/http",
+		"operation", "middleware",
+		"middleware_name", middlewareName,
+		"duration_ms", float64(duration)/1e6,
+	)
+}
+
+// LogError logs HTTP-related errors (implements HTTPAdapter interface)
+func (h *HTTPAdapter) LogError(err error, method, path string, statusCode int) {
+	h.GetLogger().Errorw("HTTP request failed",
+		"component", "net/http",
+		"operation", "http_error",
+		"method", method,
+		"path", path,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+}
+
+func (h *HTTPAdapter) logRequest(reqLogger core.Logger, r *http.Request, rw *responseWriter, latency time.Duration, panicked bool) {
+	route := r.Pattern
+	if route == "" {
+		route = r.URL.Path
+	}
+
+	logFields := []interface{}{
+		"component", "net/http",
+		"method", r.Method,
+		"route", route,
+		"path", r.URL.Path,
+		"status_code", rw.status,
+		"bytes", rw.bytes,
+		"latency_ms", float64(latency.Nanoseconds()) / 1e6,
+		"client_ip", integrations.ClientIP(r),
+		"user_agent", r.UserAgent(),
+	}
+	if panicked {
+		logFields = append(logFields, "panic", true)
+	}
+
+	level := h.levelForStatus(rw.status)
+	if h.config.SlowThreshold > 0 && latency > h.config.SlowThreshold {
+		logFields = append(logFields, "slow_request", true)
+		if level < core.WarnLevel {
+			level = core.WarnLevel
+		}
+	}
+
+	integrations.LogAtLevel(reqLogger, level, r.Method+" "+route, logFields...)
+}
+
+func (h *HTTPAdapter) levelForStatus(statusCode int) core.Level {
+	if level, ok := h.config.StatusLevels[statusCode]; ok {
+		return level
+	}
+	return integrations.LevelForStatus(statusCode)
+}
+
+// responseWriter records the status code and number of bytes written
+type responseWriter struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.status = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// Flush implements http.Flusher when the underlying writer supports it
+func (w *responseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker when the underlying writer supports it,
+// so protocol upgrades such as websockets work behind the middleware
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("underlying ResponseWriter does not implement http.Hijacker")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && !w.wroteHeader {
+		w.status = http.StatusSwitchingProtocols
+		w.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,225 @@
+package nethttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+func TestHTTPAdapter_ImplementsInterface(t *testing.T) {
+	var _ integrations.HTTPAdapter = NewHTTPAdapter(logtest.New())
+}
+
+func TestMiddleware_LogsRequest(t *testing.T) {
+	logger := logtest.New()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		FromContext(r.Context()).Infow("handling user")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("hello"))
+	})
+	handler := Middleware(logger, DefaultConfig())(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("User-Agent", "test-agent")
+	req.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	requestID := rec.Header().Get("X-Request-ID")
+	if requestID == "" {
+		t.Fatal("Expected generated request ID in response header")
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].Fields["request_id"] != requestID {
+		t.Errorf("Expected handler logger to carry request ID, got %v", entries[0].Fields)
+	}
+
+	access := entries[1]
+	if access.Level != core.InfoLevel {
+		t.Errorf("Expected info level, got %s", access.Level)
+	}
+	expected := map[string]interface{}{
+		"method":      "GET",
+		"route":       "GET /users/{id}",
+		"path":        "/users/42",
+		"status_code": http.StatusCreated,
+		"bytes":       5,
+		"client_ip":   "203.0.113.7",
+		"user_agent":  "test-agent",
+		"request_id":  requestID,
+	}
+	for key, want := range expected {
+		if got := access.Fields[key]; got != want {
+			t.Errorf("Field %s = %v, want %v", key, got, want)
+		}
+	}
+	if _, ok := access.Fields["latency_ms"]; !ok {
+		t.Error("Expected latency_ms field")
+	}
+}
+
+func TestMiddleware_PropagatesRequestID(t *testing.T) {
+	logger := logtest.New()
+	var seen string
+	handler := Middleware(logger, DefaultConfig())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = integrations.RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-ID", "abc-123")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if seen != "abc-123" || rec.Header().Get("X-Request-ID") != "abc-123" {
+		t.Errorf("Expected incoming request ID to be propagated, got context=%q header=%q",
+			seen, rec.Header().Get("X-Request-ID"))
+	}
+}
+
+func TestMiddleware_RecoversPanic(t *testing.T) {
+	logger := logtest.New()
+	handler := Middleware(logger, DefaultConfig())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/explode", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", rec.Code)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected panic and access log entries, got %d", len(entries))
+	}
+	if stack, _ := entries[0].Fields["panic_stack"].(string); entries[0].Fields["error"] != "boom" || !strings.Contains(stack, "panic") {
+		t.Errorf("Expected panic entry with panic_stack, got %v", entries[0].Fields)
+	}
+	if _, ok := entries[0].Fields["stacktrace"]; ok {
+		t.Error("Expected the panic stack not to use the engine's stacktrace key")
+	}
+	if entries[1].Level != core.ErrorLevel || entries[1].Fields["status_code"] != http.StatusInternalServerError {
+		t.Errorf("Expected error-level access log with status 500, got %+v", entries[1])
+	}
+}
+
+func TestMiddleware_DisableRecoveryLogsPanic(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.DisableRecovery = true
+	handler := Middleware(logger, config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	defer func() {
+		if rec := recover(); rec != "boom" {
+			t.Errorf("Expected the panic to propagate, got %v", rec)
+		}
+		entries := logger.Entries()
+		if len(entries) != 1 {
+			t.Fatalf("Expected only the access log, got %+v", entries)
+		}
+		if entries[0].Level != core.ErrorLevel || entries[0].Fields["status_code"] != http.StatusInternalServerError || entries[0].Fields["panic"] != true {
+			t.Errorf("Expected the access log to record the panic as a 500, got %+v", entries[0])
+		}
+	}()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/explode", nil))
+}
+
+func TestMiddleware_RepanicsAbortHandler(t *testing.T) {
+	logger := logtest.New()
+	handler := Middleware(logger, DefaultConfig())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	defer func() {
+		if rec := recover(); rec != http.ErrAbortHandler {
+			t.Errorf("Expected http.ErrAbortHandler to propagate, got %v", rec)
+		}
+		if entries := logger.Entries(); len(entries) != 0 {
+			t.Errorf("Expected an aborted handler not to be logged as a panic, got %+v", entries)
+		}
+	}()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/abort", nil))
+}
+
+func TestMiddleware_Hijack(t *testing.T) {
+	logger := logtest.New()
+	server := httptest.NewServer(Middleware(logger, DefaultConfig())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, buf, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Errorf("Hijack failed: %v", err)
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: test\r\n\r\n")
+		buf.Flush()
+	})))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/ws", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "test")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("Expected 101, got %d", resp.StatusCode)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(logger.Entries()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	entries := logger.Entries()
+	if len(entries) != 1 || entries[0].Fields["status_code"] != http.StatusSwitchingProtocols {
+		t.Errorf("Expected one access log with status 101, got %+v", entries)
+	}
+}
+
+func TestMiddleware_SkipPathsAndStatusLevels(t *testing.T) {
+	logger := logtest.New()
+	config := DefaultConfig()
+	config.SkipPaths = []string{"/healthz", "/static/*"}
+	config.StatusLevels = map[int]core.Level{http.StatusNotFound: core.DebugLevel}
+	config.SlowThreshold = 10 * time.Millisecond
+
+	handler := Middleware(logger, config)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/slow":
+			time.Sleep(20 * time.Millisecond)
+		}
+	}))
+
+	for _, path := range []string{"/healthz", "/static/app.js", "/missing", "/slow"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected skipped paths not to be logged, got %d entries", len(entries))
+	}
+	if entries[0].Fields["path"] != "/missing" || entries[0].Level != core.DebugLevel {
+		t.Errorf("Expected 404 at configured debug level, got %+v", entries[0])
+	}
+	if entries[1].Level != core.WarnLevel || entries[1].Fields["slow_request"] != true {
+		t.Errorf("Expected slow request at warn level, got %+v", entries[1])
+	}
+}
@@ -0,0 +1,183 @@
+// Package slog exposes a core.Logger as a log/slog Handler so libraries that
+// accept a *slog.Logger write through the unified logging pipeline.
+package slog
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kart-io/logger/core"
+)
+
+// handlerCallerSkip skips Handle and the slog.Logger frames between the
+// caller and the handler so the reported caller is the code that logged.
+const handlerCallerSkip = 3
+
+// HandlerOptions configures a Handler
+type HandlerOptions struct {
+	// Level is the minimum level reported as enabled. When nil the wrapped
+	// core.Logger's level is used if it implements core.LevelEnabler.
+	Level slog.Leveler
+}
+
+// Handler implements slog.Handler on top of a core.Logger
+type Handler struct {
+	logger core.Logger
+	opts   HandlerOptions
+	groups []group
+}
+
+// group is an open slog group together with the attributes added inside it
+type group struct {
+	name  string
+	attrs []slog.Attr
+}
+
+// NewHandler creates a slog.Handler writing into logger. Top-level attributes
+// go through logger.With so they receive the engine's field standardization.
+func NewHandler(logger core.Logger, opts *HandlerOptions) *Handler {
+	h := &Handler{logger: logger.WithCallerSkip(handlerCallerSkip)}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+// NewLogger creates a *slog.Logger writing into logger
+func NewLogger(logger core.Logger) *slog.Logger {
+	return slog.New(NewHandler(logger, nil))
+}
+
+// Enabled reports whether records at level are handled. Without a minimum
+// level it asks the wrapped logger, when the logger can report its level.
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	if h.opts.Level != nil {
+		return level >= h.opts.Level.Level()
+	}
+	if enabler, ok := h.logger.(core.LevelEnabler); ok {
+		return enabler.Enabled(coreLevel(level))
+	}
+	return true
+}
+
+// Handle writes the record through the wrapped logger
+func (h *Handler) Handle(_ context.Context, record slog.Record) error {
+	attrs := make([]slog.Attr, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, attr)
+		return true
+	})
+
+	// Fold the record attributes into the open groups, innermost first
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		g := h.groups[i]
+		inner := append(append([]slog.Attr{}, g.attrs...), attrs...)
+		attrs = nil
+		if len(inner) > 0 {
+			attrs = []slog.Attr{{Key: g.name, Value: slog.GroupValue(inner...)}}
+		}
+	}
+
+	keysAndValues := appendAttrs(nil, attrs)
+	switch coreLevel(record.Level) {
+	case core.DebugLevel:
+		h.logger.Debugw(record.Message, keysAndValues...)
+	case core.InfoLevel:
+		h.logger.Infow(record.Message, keysAndValues...)
+	case core.WarnLevel:
+		h.logger.Warnw(record.Message, keysAndValues...)
+	default:
+		h.logger.Errorw(record.Message, keysAndValues...)
+	}
+	return nil
+}
+
+// coreLevel maps a slog level to the core level it is written at; levels
+// above error stay at error so records never trigger a fatal exit
+func coreLevel(level slog.Level) core.Level {
+	switch {
+	case level < slog.LevelInfo:
+		return core.DebugLevel
+	case level < slog.LevelWarn:
+		return core.InfoLevel
+	case level < slog.LevelError:
+		return core.WarnLevel
+	default:
+		return core.ErrorLevel
+	}
+}
+
+// WithAttrs returns a handler that adds attrs to every record
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	clone := h.clone()
+	if len(clone.groups) == 0 {
+		clone.logger = clone.logger.With(appendAttrs(nil, attrs)...)
+		return clone
+	}
+
+	last := &clone.groups[len(clone.groups)-1]
+	last.attrs = append(append([]slog.Attr{}, last.attrs...), attrs...)
+	return clone
+}
+
+// WithGroup returns a handler that nests subsequent attributes under name
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	clone := h.clone()
+	clone.groups = append(clone.groups, group{name: name})
+	return clone
+}
+
+func (h *Handler) clone() *Handler {
+	return &Handler{
+		logger: h.logger,
+		opts:   h.opts,
+		groups: append([]group{}, h.groups...),
+	}
+}
+
+// appendAttrs converts slog attributes to key-value pairs, turning groups
+// into nested maps and inlining groups with an empty key
+func appendAttrs(keysAndValues []interface{}, attrs []slog.Attr) []interface{} {
+	for _, attr := range attrs {
+		attr.Value = attr.Value.Resolve()
+		if attr.Equal(slog.Attr{}) {
+			continue
+		}
+
+		if attr.Value.Kind() == slog.KindGroup {
+			groupAttrs := attr.Value.Group()
+			if len(groupAttrs) == 0 {
+				continue
+			}
+			if attr.Key == "" {
+				keysAndValues = appendAttrs(keysAndValues, groupAttrs)
+				continue
+			}
+			keysAndValues = append(keysAndValues, attr.Key, groupMap(groupAttrs))
+			continue
+		}
+
+		keysAndValues = append(keysAndValues, attr.Key, attr.Value.Any())
+	}
+	return keysAndValues
+}
+
+func groupMap(attrs []slog.Attr) map[string]interface{} {
+	m := make(map[string]interface{}, len(attrs))
+	kv := appendAttrs(nil, attrs)
+	for i := 0; i+1 < len(kv); i += 2 {
+		m[kv[i].(string)] = kv[i+1]
+	}
+	return m
+}
+
+// Verify that Handler implements slog.Handler
+var _ slog.Handler = (*Handler)(nil)
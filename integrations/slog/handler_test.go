@@ -0,0 +1,165 @@
+package slog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+	"github.com/kart-io/logger/option"
+)
+
+func TestHandler_Levels(t *testing.T) {
+	recorder := logtest.New()
+	log := NewLogger(recorder)
+
+	log.Debug("d")
+	log.Info("i")
+	log.Warn("w")
+	log.Error("e", "err", errors.New("boom"))
+	log.Log(context.Background(), slog.LevelError+4, "beyond error")
+
+	expected := []core.Level{core.DebugLevel, core.InfoLevel, core.WarnLevel, core.ErrorLevel, core.ErrorLevel}
+	entries := recorder.Entries()
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, want := range expected {
+		if entries[i].Level != want {
+			t.Errorf("Entry %d level = %s, want %s", i, entries[i].Level, want)
+		}
+	}
+}
+
+func TestHandler_Enabled(t *testing.T) {
+	h := NewHandler(logtest.New(), &HandlerOptions{Level: slog.LevelWarn})
+	if h.Enabled(context.Background(), slog.LevelInfo) || !h.Enabled(context.Background(), slog.LevelWarn) {
+		t.Error("Expected minimum level to be respected")
+	}
+	if !NewHandler(logtest.New(), nil).Enabled(context.Background(), slog.LevelDebug) {
+		t.Error("Expected every level enabled without options")
+	}
+}
+
+func TestHandler_EnabledFollowsEngineLevel(t *testing.T) {
+	for _, engine := range []string{"zap", "slog"} {
+		t.Run(engine, func(t *testing.T) {
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.Level = "WARN"
+			opt.OutputPaths = []string{filepath.Join(t.TempDir(), "app.log")}
+
+			coreLogger, err := logger.New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			h := NewHandler(coreLogger, nil)
+			if h.Enabled(context.Background(), slog.LevelInfo) || !h.Enabled(context.Background(), slog.LevelWarn) {
+				t.Error("Expected the engine's level to be respected")
+			}
+
+			coreLogger.SetLevel(core.DebugLevel)
+			if !h.Enabled(context.Background(), slog.LevelDebug) {
+				t.Error("Expected a level change on the logger to be seen by the handler")
+			}
+		})
+	}
+}
+
+func TestHandler_AttrsAndGroups(t *testing.T) {
+	recorder := logtest.New()
+	log := NewLogger(recorder).With("service", "api").
+		WithGroup("http").With("method", "GET").
+		WithGroup("response")
+
+	log.Info("request", "status", 200, slog.Group("", slog.Int("inlined", 1)), slog.Group("empty"))
+	log.Info("no record attrs")
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	first := entries[0].Fields
+	if first["service"] != "api" {
+		t.Errorf("Expected top-level attribute, got %v", first)
+	}
+	httpGroup, ok := first["http"].(map[string]interface{})
+	if !ok || httpGroup["method"] != "GET" {
+		t.Fatalf("Expected http group with method, got %v", first["http"])
+	}
+	response, ok := httpGroup["response"].(map[string]interface{})
+	if !ok || response["status"] != int64(200) || response["inlined"] != int64(1) {
+		t.Errorf("Expected nested response group, got %v", httpGroup["response"])
+	}
+	if _, ok := response["empty"]; ok {
+		t.Error("Expected empty group to be dropped")
+	}
+
+	second := entries[1].Fields
+	if httpGroup, ok := second["http"].(map[string]interface{}); !ok || httpGroup["response"] != nil {
+		t.Errorf("Expected empty response group to be omitted, got %v", second["http"])
+	}
+}
+
+type secret string
+
+func (secret) LogValue() slog.Value { return slog.StringValue("***") }
+
+func TestHandler_ResolvesLogValuer(t *testing.T) {
+	recorder := logtest.New()
+	NewLogger(recorder).Info("login", "password", secret("hunter2"))
+
+	if got := recorder.Entries()[0].Fields["password"]; got != "***" {
+		t.Errorf("Expected LogValuer to be resolved, got %v", got)
+	}
+}
+
+func TestHandler_EnginesReportCaller(t *testing.T) {
+	for _, engine := range []string{"zap", "slog"} {
+		t.Run(engine, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.OutputPaths = []string{logFile}
+
+			coreLogger, err := logger.New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			NewLogger(coreLogger).With("trace.id", "abc").Info("from slog", "user", "alice")
+
+			data, err := os.ReadFile(logFile)
+			if err != nil {
+				t.Fatalf("Failed to read log file: %v", err)
+			}
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(strings.TrimSpace(string(data))), &entry); err != nil {
+				t.Fatalf("Failed to parse %q: %v", data, err)
+			}
+
+			message := entry["message"]
+			if message == nil {
+				message = entry["msg"]
+			}
+			if message != "from slog" || entry["user"] != "alice" {
+				t.Errorf("Unexpected entry %v", entry)
+			}
+			if entry["trace_id"] != "abc" {
+				t.Errorf("Expected field standardization of trace.id, got %v", entry)
+			}
+			if caller, _ := entry["caller"].(string); !strings.Contains(caller, "handler_test.go") {
+				t.Errorf("Expected caller in test file, got %q", caller)
+			}
+		})
+	}
+}
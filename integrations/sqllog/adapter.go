@@ -0,0 +1,215 @@
+// Package sqllog logs database/sql activity by wrapping a driver.Driver or
+// driver.Connector, for services that use database/sql or sqlx without GORM.
+package sqllog
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync/atomic"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/integrations"
+)
+
+// RedactedValue replaces query arguments when RedactArgs is enabled
+const RedactedValue = "[REDACTED]"
+
+// Config holds configuration for the database/sql adapter
+type Config struct {
+	// SlowThreshold logs statements slower than this at warn level (0 disables)
+	SlowThreshold time.Duration
+
+	// LogArgs includes query arguments in log entries
+	LogArgs bool
+
+	// RedactArgs replaces every logged argument with RedactedValue
+	RedactArgs bool
+
+	// RedactArg, when set, is applied to each logged argument instead of
+	// RedactArgs; name is empty for positional arguments
+	RedactArg func(ordinal int, name string, value driver.Value) interface{}
+}
+
+// DefaultConfig returns default configuration for the database/sql adapter
+func DefaultConfig() Config {
+	return Config{
+		SlowThreshold: 200 * time.Millisecond,
+		LogArgs:       true,
+	}
+}
+
+// SQLAdapter logs database/sql statements, transactions and errors
+type SQLAdapter struct {
+	*integrations.BaseAdapter
+	config        Config
+	slowThreshold atomic.Int64
+}
+
+// NewSQLAdapter creates a new database/sql adapter
+func NewSQLAdapter(coreLogger core.Logger) *SQLAdapter {
+	return NewSQLAdapterWithConfig(coreLogger, DefaultConfig())
+}
+
+// NewSQLAdapterWithConfig creates a new database/sql adapter with configuration
+func NewSQLAdapterWithConfig(coreLogger core.Logger, config Config) *SQLAdapter {
+	adapter := &SQLAdapter{
+		BaseAdapter: integrations.NewBaseAdapter(coreLogger, "database/sql", "go1.x"),
+		config:      config,
+	}
+	adapter.slowThreshold.Store(int64(config.SlowThreshold))
+	return adapter
+}
+
+// SetSlowThreshold sets the slow query threshold; it is safe to call while
+// statements are running
+func (s *SQLAdapter) SetSlowThreshold(threshold time.Duration) {
+	s.slowThreshold.Store(int64(threshold))
+}
+
+// GetSlowThreshold returns the current slow query threshold
+func (s *SQLAdapter) GetSlowThreshold() time.Duration {
+	return time.Duration(s.slowThreshold.Load())
+}
+
+// LogQuery logs a database query (implements DatabaseAdapter interface)
+func (s *SQLAdapter) LogQuery(query string, duration int64, params ...interface{}) {
+	logFields := []interface{}{
+		"component", "database/sql",
+		"operation", "query",
+		"query", query,
+		"duration_ns", duration,
+	}
+	logFields = append(logFields, params...)
+
+	s.GetLogger().Infow("Database query executed", logFields...)
+}
+
+// LogError logs a database error (implements DatabaseAdapter interface)
+func (s *SQLAdapter) LogError(err error, query string, params ...interface{}) {
+	logFields := []interface{}{
+		"component", "database/sql",
+		"operation", "query",
+		"query", query,
+		"error", err.Error(),
+	}
+	logFields = append(logFields, params...)
+
+	s.GetLogger().Errorw("Database query failed", logFields...)
+}
+
+// LogSlowQuery logs queries that exceed the slow query threshold (implements DatabaseAdapter interface)
+func (s *SQLAdapter) LogSlowQuery(query string, duration int64, threshold int64, params ...interface{}) {
+	logFields := []interface{}{
+		"component", "database/sql",
+		"operation", "slow_query",
+		"query", query,
+		"duration_ns", duration,
+		"threshold_ns", threshold,
+		"slowdown_factor", float64(duration) / float64(threshold),
+	}
+	logFields = append(logFields, params...)
+
+	s.GetLogger().Warnw("Slow database query detected", logFields...)
+}
+
+// logStatement logs an executed statement the way GormAdapter.Trace does:
+// errors first, then statements above the slow threshold, then the rest
+func (s *SQLAdapter) logStatement(ctx context.Context, operation, query string, args []driver.NamedValue, begin time.Time, rows int64, err error) {
+	if err == driver.ErrSkip {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	logFields := []interface{}{
+		"component", "database/sql",
+		"operation", operation,
+		"query", query,
+		"duration_ms", float64(elapsed.Nanoseconds()) / 1e6,
+	}
+	if s.config.LogArgs && len(args) > 0 {
+		logFields = append(logFields, "args", s.formatArgs(args))
+	}
+	if rows >= 0 {
+		logFields = append(logFields, "rows_affected", rows)
+	}
+
+	logger := s.loggerFor(ctx)
+	threshold := s.GetSlowThreshold()
+	switch {
+	case err != nil:
+		logFields = append(logFields, fields.ErrorField, err)
+		logger.Errorw("Database query failed", logFields...)
+	case threshold != 0 && elapsed > threshold:
+		logFields = append(logFields,
+			"slow_query", true,
+			"threshold_ms", float64(threshold.Nanoseconds())/1e6,
+		)
+		logger.Warnw("Slow database query detected", logFields...)
+	default:
+		logger.Infow("Database query executed", logFields...)
+	}
+}
+
+// logTx logs a transaction boundary
+func (s *SQLAdapter) logTx(ctx context.Context, operation string, begin time.Time, err error) {
+	logFields := []interface{}{
+		"component", "database/sql",
+		"operation", operation,
+	}
+	if operation != "begin" {
+		logFields = append(logFields, "duration_ms", float64(time.Since(begin).Nanoseconds())/1e6)
+	}
+
+	logger := s.loggerFor(ctx)
+	if err != nil {
+		logFields = append(logFields, fields.ErrorField, err)
+		logger.Errorw("Database transaction "+operation+" failed", logFields...)
+		return
+	}
+	logger.Debugw("Database transaction "+operation, logFields...)
+}
+
+// logConnectError logs a failure to open a new connection
+func (s *SQLAdapter) logConnectError(ctx context.Context, err error) {
+	s.loggerFor(ctx).Errorw("Database connection failed",
+		"component", "database/sql",
+		"operation", "connect",
+		fields.ErrorField, err,
+	)
+}
+
+func (s *SQLAdapter) formatArgs(args []driver.NamedValue) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch {
+		case s.config.RedactArg != nil:
+			values[i] = s.config.RedactArg(arg.Ordinal, arg.Name, arg.Value)
+		case s.config.RedactArgs:
+			values[i] = RedactedValue
+		default:
+			values[i] = arg.Value
+		}
+	}
+	return values
+}
+
+// loggerFor returns a logger carrying the trace and request IDs found in ctx
+func (s *SQLAdapter) loggerFor(ctx context.Context) core.Logger {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var keysAndValues []interface{}
+	if traceID := integrations.TraceIDFromContext(ctx); traceID != "" {
+		keysAndValues = append(keysAndValues, fields.TraceIDField, traceID)
+	}
+	if requestID := integrations.RequestIDFromContext(ctx); requestID != "" {
+		keysAndValues = append(keysAndValues, fields.RequestIDField, requestID)
+	}
+	return s.GetLogger().WithCtx(ctx, keysAndValues...)
+}
+
+// Verify that SQLAdapter implements the DatabaseAdapter interface
+var _ integrations.DatabaseAdapter = (*SQLAdapter)(nil)
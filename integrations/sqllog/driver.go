@@ -0,0 +1,378 @@
+package sqllog
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+)
+
+// Open opens a database like sql.Open, logging every statement through adapter.
+// driverName must be registered with database/sql.
+func Open(driverName, dsn string, adapter *SQLAdapter) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	d := db.Driver()
+	// The pool is only used to look up the driver; it has no open connections
+	_ = db.Close()
+
+	connector, err := WrapDriver(d, adapter).(driver.DriverContext).OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
+
+// WrapDriver returns a driver.Driver whose connections log through adapter.
+// Register the result with sql.Register to use it by name.
+func WrapDriver(d driver.Driver, adapter *SQLAdapter) driver.Driver {
+	return &wrappedDriver{driver: d, adapter: adapter}
+}
+
+// WrapConnector returns a driver.Connector whose connections log through
+// adapter; pass the result to sql.OpenDB
+func WrapConnector(c driver.Connector, adapter *SQLAdapter) driver.Connector {
+	return &wrappedConnector{connector: c, driver: &wrappedDriver{driver: c.Driver(), adapter: adapter}, adapter: adapter}
+}
+
+type wrappedDriver struct {
+	driver  driver.Driver
+	adapter *SQLAdapter
+}
+
+func (d *wrappedDriver) Open(name string) (driver.Conn, error) {
+	c, err := d.driver.Open(name)
+	if err != nil {
+		d.adapter.logConnectError(context.Background(), err)
+		return nil, err
+	}
+	return &conn{conn: c, adapter: d.adapter}, nil
+}
+
+func (d *wrappedDriver) OpenConnector(name string) (driver.Connector, error) {
+	if dc, ok := d.driver.(driver.DriverContext); ok {
+		c, err := dc.OpenConnector(name)
+		if err != nil {
+			return nil, err
+		}
+		return &wrappedConnector{connector: c, driver: d, adapter: d.adapter}, nil
+	}
+	return &dsnConnector{dsn: name, driver: d}, nil
+}
+
+type wrappedConnector struct {
+	connector driver.Connector
+	driver    *wrappedDriver
+	adapter   *SQLAdapter
+}
+
+func (c *wrappedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	dc, err := c.connector.Connect(ctx)
+	if err != nil {
+		c.adapter.logConnectError(ctx, err)
+		return nil, err
+	}
+	return &conn{conn: dc, adapter: c.adapter}, nil
+}
+
+func (c *wrappedConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// dsnConnector adapts a driver without DriverContext, like database/sql does
+type dsnConnector struct {
+	dsn    string
+	driver *wrappedDriver
+}
+
+func (c *dsnConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// conn logs statements executed directly on a connection and wraps the
+// statements and transactions it creates
+type conn struct {
+	conn    driver.Conn
+	adapter *SQLAdapter
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	begin := time.Now()
+
+	var (
+		s   driver.Stmt
+		err error
+	)
+	if pc, ok := c.conn.(driver.ConnPrepareContext); ok {
+		s, err = pc.PrepareContext(ctx, query)
+	} else {
+		s, err = c.conn.Prepare(query)
+	}
+	if err != nil {
+		c.adapter.logStatement(ctx, "prepare", query, nil, begin, -1, err)
+		return nil, err
+	}
+	return &stmt{stmt: s, conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	begin := time.Now()
+
+	var (
+		t   driver.Tx
+		err error
+	)
+	if bc, ok := c.conn.(driver.ConnBeginTx); ok {
+		t, err = bc.BeginTx(ctx, opts)
+	} else if opts.Isolation != driver.IsolationLevel(sql.LevelDefault) {
+		err = errors.New("sqllog: driver does not support non-default isolation level")
+	} else if opts.ReadOnly {
+		err = errors.New("sqllog: driver does not support read-only transactions")
+	} else {
+		//nolint:staticcheck // fallback for drivers without ConnBeginTx
+		t, err = c.conn.Begin()
+	}
+
+	c.adapter.logTx(ctx, "begin", begin, err)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{tx: t, ctx: ctx, begin: begin, adapter: c.adapter}, nil
+}
+
+// ExecContext executes on the connection when the driver supports it;
+// otherwise driver.ErrSkip makes database/sql prepare a statement instead
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	begin := time.Now()
+
+	var (
+		result driver.Result
+		err    error
+	)
+	switch execer := c.conn.(type) {
+	case driver.ExecerContext:
+		result, err = execer.ExecContext(ctx, query, args)
+	case driver.Execer: //nolint:staticcheck // fallback for older drivers
+		var values []driver.Value
+		if values, err = namedValueToValue(args); err == nil {
+			result, err = execer.Exec(query, values)
+		}
+	default:
+		return nil, driver.ErrSkip
+	}
+
+	c.adapter.logStatement(ctx, "exec", query, args, begin, rowsAffected(result, err), err)
+	return result, err
+}
+
+// QueryContext queries on the connection when the driver supports it;
+// otherwise driver.ErrSkip makes database/sql prepare a statement instead
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	begin := time.Now()
+
+	var (
+		rows driver.Rows
+		err  error
+	)
+	switch queryer := c.conn.(type) {
+	case driver.QueryerContext:
+		rows, err = queryer.QueryContext(ctx, query, args)
+	case driver.Queryer: //nolint:staticcheck // fallback for older drivers
+		var values []driver.Value
+		if values, err = namedValueToValue(args); err == nil {
+			rows, err = queryer.Query(query, values)
+		}
+	default:
+		return nil, driver.ErrSkip
+	}
+
+	c.adapter.logStatement(ctx, "query", query, args, begin, -1, err)
+	return rows, err
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if pinger, ok := c.conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *conn) IsValid() bool {
+	if validator, ok := c.conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// stmt logs each execution of a prepared statement
+type stmt struct {
+	stmt  driver.Stmt
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error {
+	return s.stmt.Close()
+}
+
+func (s *stmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), valueToNamedValue(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), valueToNamedValue(args))
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	begin := time.Now()
+
+	var (
+		result driver.Result
+		err    error
+	)
+	if execer, ok := s.stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValueToValue(args); err == nil {
+			//nolint:staticcheck // fallback for drivers without StmtExecContext
+			result, err = s.stmt.Exec(values)
+		}
+	}
+
+	s.conn.adapter.logStatement(ctx, "exec", s.query, args, begin, rowsAffected(result, err), err)
+	return result, err
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	begin := time.Now()
+
+	var (
+		rows driver.Rows
+		err  error
+	)
+	if queryer, ok := s.stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValueToValue(args); err == nil {
+			//nolint:staticcheck // fallback for drivers without StmtQueryContext
+			rows, err = s.stmt.Query(values)
+		}
+	}
+
+	s.conn.adapter.logStatement(ctx, "query", s.query, args, begin, -1, err)
+	return rows, err
+}
+
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+// tx logs the end of a transaction together with its total duration
+type tx struct {
+	tx      driver.Tx
+	ctx     context.Context
+	begin   time.Time
+	adapter *SQLAdapter
+}
+
+func (t *tx) Commit() error {
+	err := t.tx.Commit()
+	t.adapter.logTx(t.ctx, "commit", t.begin, err)
+	return err
+}
+
+func (t *tx) Rollback() error {
+	err := t.tx.Rollback()
+	t.adapter.logTx(t.ctx, "rollback", t.begin, err)
+	return err
+}
+
+// rowsAffected returns the affected row count, or -1 when it is unavailable
+func rowsAffected(result driver.Result, err error) int64 {
+	if err != nil || result == nil {
+		return -1
+	}
+	rows, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		return -1
+	}
+	return rows
+}
+
+func namedValueToValue(named []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(named))
+	for i, nv := range named {
+		if nv.Name != "" {
+			return nil, errors.New("sqllog: driver does not support the use of Named Parameters")
+		}
+		values[i] = nv.Value
+	}
+	return values, nil
+}
+
+func valueToNamedValue(values []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(values))
+	for i, v := range values {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return named
+}
+
+// Verify that the wrappers implement the optional driver interfaces
+var (
+	_ driver.DriverContext      = (*wrappedDriver)(nil)
+	_ driver.Connector          = (*wrappedConnector)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+	_ driver.SessionResetter    = (*conn)(nil)
+	_ driver.Validator          = (*conn)(nil)
+	_ driver.NamedValueChecker  = (*conn)(nil)
+	_ driver.StmtExecContext    = (*stmt)(nil)
+	_ driver.StmtQueryContext   = (*stmt)(nil)
+	_ driver.NamedValueChecker  = (*stmt)(nil)
+)
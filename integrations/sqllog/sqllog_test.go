@@ -0,0 +1,377 @@
+package sqllog
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+)
+
+// fakeDriver is a minimal driver: statements containing "fail" return an
+// error, other execs affect 3 rows and queries return a single row
+type fakeDriver struct {
+	directExec bool
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	if name == "unreachable" {
+		return nil, errors.New("connection refused")
+	}
+	if d.directExec {
+		return &fakeExecConn{}, nil
+	}
+	return &fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	if strings.Contains(query, "syntax error") {
+		return nil, errors.New("near \"SELEC\": syntax error")
+	}
+	return &fakeStmt{query: query}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return &fakeTx{}, nil }
+
+// fakeExecConn executes statements directly instead of preparing them
+type fakeExecConn struct {
+	fakeConn
+}
+
+func (c *fakeExecConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if strings.Contains(query, "fail") {
+		return nil, errors.New("constraint failed")
+	}
+	return driver.RowsAffected(5), nil
+}
+
+type fakeStmt struct {
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(_ []driver.Value) (driver.Result, error) {
+	if strings.Contains(s.query, "fail") {
+		return nil, errors.New("constraint failed")
+	}
+	return driver.RowsAffected(3), nil
+}
+
+func (s *fakeStmt) Query(_ []driver.Value) (driver.Rows, error) {
+	if strings.Contains(s.query, "fail") {
+		return nil, errors.New("no such table")
+	}
+	return &fakeRows{}, nil
+}
+
+type fakeRows struct {
+	done bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
+
+type fakeTx struct{}
+
+func (t *fakeTx) Commit() error   { return nil }
+func (t *fakeTx) Rollback() error { return nil }
+
+func init() {
+	sql.Register("sqllog-fake", &fakeDriver{})
+}
+
+func openTestDB(t *testing.T, adapter *SQLAdapter) *sql.DB {
+	t.Helper()
+	db := sql.OpenDB(WrapConnector(&fakeConnector{driver: &fakeDriver{}}, adapter))
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+type fakeConnector struct {
+	driver *fakeDriver
+	dsn    string
+}
+
+func (c *fakeConnector) Connect(_ context.Context) (driver.Conn, error) { return c.driver.Open(c.dsn) }
+func (c *fakeConnector) Driver() driver.Driver                          { return c.driver }
+
+func TestSQLAdapter_Exec(t *testing.T) {
+	recorder := logtest.New()
+	db := openTestDB(t, NewSQLAdapter(recorder))
+
+	ctx := integrations.ContextWithTraceID(context.Background(), "trace-1")
+	ctx = integrations.ContextWithRequestID(ctx, "req-1")
+	if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", 7); err != nil {
+		t.Fatalf("ExecContext failed: %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d: %+v", len(entries), entries)
+	}
+	entry := entries[0]
+	if entry.Level != core.InfoLevel || entry.Msg != "Database query executed" {
+		t.Errorf("Unexpected entry %+v", entry)
+	}
+	if entry.Fields["query"] != "UPDATE users SET name = ? WHERE id = ?" {
+		t.Errorf("Unexpected query field %v", entry.Fields["query"])
+	}
+	if entry.Fields["rows_affected"] != int64(3) {
+		t.Errorf("Expected rows_affected 3, got %v", entry.Fields["rows_affected"])
+	}
+	args, _ := entry.Fields["args"].([]interface{})
+	if len(args) != 2 || args[0] != "alice" || args[1] != int64(7) {
+		t.Errorf("Unexpected args %v", entry.Fields["args"])
+	}
+	if entry.Fields["trace_id"] != "trace-1" || entry.Fields["request_id"] != "req-1" {
+		t.Errorf("Expected trace and request IDs, got %v", entry.Fields)
+	}
+	if _, ok := entry.Fields["duration_ms"]; !ok {
+		t.Error("Expected duration_ms field")
+	}
+}
+
+func TestSQLAdapter_Query(t *testing.T) {
+	recorder := logtest.New()
+	db := openTestDB(t, NewSQLAdapter(recorder))
+
+	var id int64
+	if err := db.QueryRow("SELECT id FROM users WHERE name = ?", "alice").Scan(&id); err != nil {
+		t.Fatalf("QueryRow failed: %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].Fields["operation"] != "query" {
+		t.Errorf("Expected query operation, got %v", entries[0].Fields["operation"])
+	}
+	if _, ok := entries[0].Fields["rows_affected"]; ok {
+		t.Error("Expected no rows_affected field for queries")
+	}
+}
+
+func TestSQLAdapter_Errors(t *testing.T) {
+	recorder := logtest.New()
+	db := openTestDB(t, NewSQLAdapter(recorder))
+
+	if _, err := db.Exec("INSERT INTO fail VALUES (1)"); err == nil {
+		t.Fatal("Expected exec error")
+	}
+	if _, err := db.Exec("SELEC syntax error"); err == nil {
+		t.Fatal("Expected prepare error")
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d: %+v", len(entries), entries)
+	}
+	if entries[0].Level != core.ErrorLevel || !isError(entries[0].Fields["error"], "constraint failed") {
+		t.Errorf("Unexpected exec error entry %+v", entries[0])
+	}
+	if entries[1].Level != core.ErrorLevel || entries[1].Fields["operation"] != "prepare" {
+		t.Errorf("Unexpected prepare error entry %+v", entries[1])
+	}
+}
+
+func TestSQLAdapter_SlowThreshold(t *testing.T) {
+	recorder := logtest.New()
+	adapter := NewSQLAdapter(recorder)
+	db := openTestDB(t, adapter)
+
+	adapter.SetSlowThreshold(time.Nanosecond)
+	if adapter.GetSlowThreshold() != time.Nanosecond {
+		t.Errorf("Expected threshold 1ns, got %v", adapter.GetSlowThreshold())
+	}
+	if _, err := db.Exec("DELETE FROM sessions"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	// A zero threshold disables slow query detection, as in GormAdapter
+	adapter.SetSlowThreshold(0)
+	if _, err := db.Exec("DELETE FROM sessions"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Level != core.WarnLevel || entries[0].Fields["slow_query"] != true {
+		t.Errorf("Expected slow query warning, got %+v", entries[0])
+	}
+	if entries[1].Level != core.InfoLevel {
+		t.Errorf("Expected info entry with threshold disabled, got %+v", entries[1])
+	}
+}
+
+func TestSQLAdapter_RedactArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   []interface{}
+	}{
+		{"redact all", Config{LogArgs: true, RedactArgs: true}, []interface{}{RedactedValue, RedactedValue}},
+		{"custom", Config{LogArgs: true, RedactArg: func(ordinal int, _ string, value driver.Value) interface{} {
+			if ordinal == 2 {
+				return RedactedValue
+			}
+			return value
+		}}, []interface{}{"alice", RedactedValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := logtest.New()
+			db := openTestDB(t, NewSQLAdapterWithConfig(recorder, tt.config))
+
+			if _, err := db.Exec("UPDATE users SET password = ? WHERE name = ?", "alice", "s3cret"); err != nil {
+				t.Fatalf("Exec failed: %v", err)
+			}
+
+			args, _ := recorder.Entries()[0].Fields["args"].([]interface{})
+			if len(args) != len(tt.want) {
+				t.Fatalf("Expected %d args, got %v", len(tt.want), args)
+			}
+			for i := range tt.want {
+				if args[i] != tt.want[i] {
+					t.Errorf("args[%d] = %v, want %v", i, args[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSQLAdapter_Transactions(t *testing.T) {
+	recorder := logtest.New()
+	db := openTestDB(t, NewSQLAdapter(recorder))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if _, err := tx.Exec("INSERT INTO users VALUES (?)", 1); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	var operations []string
+	for _, entry := range recorder.Entries() {
+		operations = append(operations, entry.Fields["operation"].(string))
+	}
+	want := []string{"begin", "exec", "commit", "begin", "rollback"}
+	if strings.Join(operations, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected operations %v, got %v", want, operations)
+	}
+}
+
+func TestSQLAdapter_DirectExec(t *testing.T) {
+	recorder := logtest.New()
+	db := sql.OpenDB(WrapConnector(&fakeConnector{driver: &fakeDriver{directExec: true}}, NewSQLAdapter(recorder)))
+	defer db.Close()
+
+	if _, err := db.Exec("DELETE FROM sessions"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 || entries[0].Fields["rows_affected"] != int64(5) {
+		t.Errorf("Expected a single direct exec entry, got %+v", entries)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	recorder := logtest.New()
+	db, err := Open("sqllog-fake", "", NewSQLAdapter(recorder))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("DELETE FROM sessions"); err != nil {
+		t.Fatalf("Exec failed: %v", err)
+	}
+	if len(recorder.Entries()) != 1 {
+		t.Errorf("Expected 1 entry, got %d", len(recorder.Entries()))
+	}
+
+	unreachable, err := Open("sqllog-fake", "unreachable", NewSQLAdapter(recorder))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer unreachable.Close()
+	if err := unreachable.Ping(); err == nil {
+		t.Fatal("Expected ping error")
+	}
+	last := recorder.Entries()[len(recorder.Entries())-1]
+	if last.Level != core.ErrorLevel || last.Fields["operation"] != "connect" {
+		t.Errorf("Expected connect error entry, got %+v", last)
+	}
+
+	if _, err := Open("sqllog-missing", "", NewSQLAdapter(recorder)); err == nil {
+		t.Error("Expected error for unregistered driver")
+	}
+}
+
+func TestSQLAdapter_ImplementsDatabaseAdapter(t *testing.T) {
+	recorder := logtest.New()
+	var adapter integrations.DatabaseAdapter = NewSQLAdapter(recorder)
+
+	if adapter.Name() != "database/sql" {
+		t.Errorf("Expected name database/sql, got %s", adapter.Name())
+	}
+
+	adapter.LogQuery("SELECT 1", int64(time.Millisecond))
+	adapter.LogSlowQuery("SELECT 1", int64(2*time.Second), int64(time.Second))
+	adapter.LogError(errors.New("boom"), "SELECT 1")
+
+	entries := recorder.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+	if entries[0].Level != core.InfoLevel || entries[1].Level != core.WarnLevel || entries[2].Level != core.ErrorLevel {
+		t.Errorf("Unexpected levels %v %v %v", entries[0].Level, entries[1].Level, entries[2].Level)
+	}
+	if entries[1].Fields["slowdown_factor"] != 2.0 {
+		t.Errorf("Expected slowdown_factor 2, got %v", entries[1].Fields["slowdown_factor"])
+	}
+}
+
+// isError reports whether v is an error with message msg, so the engines
+// expand it into the error_type and error_causes fields
+func isError(v interface{}, msg string) bool {
+	err, ok := v.(error)
+	return ok && err.Error() == msg
+}
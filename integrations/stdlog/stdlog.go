@@ -0,0 +1,114 @@
+// Package stdlog bridges the standard library log package into a core.Logger.
+package stdlog
+
+import (
+	"bytes"
+	"log"
+	"strings"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations"
+)
+
+// writerCallerSkip skips integrations.LogAtLevel, Write and the two log package
+// frames so the reported caller is the code that called log.Printf and friends.
+const writerCallerSkip = 4
+
+// NewStdLog returns a *log.Logger that writes into logger at info level
+func NewStdLog(logger core.Logger) *log.Logger {
+	return NewStdLogAt(logger, core.InfoLevel)
+}
+
+// NewStdLogAt returns a *log.Logger that writes into logger at the given level.
+// Fatal level entries are written as errors; log.Fatal exits on its own.
+func NewStdLogAt(logger core.Logger, level core.Level) *log.Logger {
+	w := &writer{logger: logger.WithCallerSkip(writerCallerSkip), level: level}
+	std := log.New(w, "", 0)
+	w.source = std
+	return std
+}
+
+// RedirectStdLog redirects the output of the standard library's global logger
+// into logger at info level. It returns a function that restores the
+// original output, prefix and flags.
+func RedirectStdLog(logger core.Logger) func() {
+	return RedirectStdLogAt(logger, core.InfoLevel)
+}
+
+// RedirectStdLogAt redirects the global logger's output into logger at the
+// given level. It returns a function that restores the original settings.
+func RedirectStdLogAt(logger core.Logger, level core.Level) func() {
+	std := log.Default()
+	flags, prefix, output := std.Flags(), std.Prefix(), std.Writer()
+
+	std.SetFlags(0)
+	std.SetPrefix("")
+	std.SetOutput(&writer{logger: logger.WithCallerSkip(writerCallerSkip), level: level, source: std})
+
+	return func() {
+		std.SetFlags(flags)
+		std.SetPrefix(prefix)
+		std.SetOutput(output)
+	}
+}
+
+// writer turns each line written by a *log.Logger into a structured entry
+type writer struct {
+	logger core.Logger
+	level  core.Level
+	// source is the *log.Logger writing into this writer; its current flags
+	// and prefix are used to strip the header it prepends to each line
+	source *log.Logger
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	msg := string(bytes.TrimRight(p, "\n"))
+
+	var prefix string
+	if w.source != nil {
+		msg, prefix = stripHeader(msg, w.source.Prefix(), w.source.Flags())
+	}
+
+	if prefix != "" {
+		integrations.LogAtLevel(w.logger, w.level, msg, "prefix", prefix)
+	} else {
+		integrations.LogAtLevel(w.logger, w.level, msg)
+	}
+	return len(p), nil
+}
+
+// stripHeader removes the prefix, timestamp and file header that the log
+// package writes for the given flags, returning the message and the trimmed prefix
+func stripHeader(line, prefix string, flags int) (string, string) {
+	if prefix != "" && flags&log.Lmsgprefix == 0 {
+		line = strings.TrimPrefix(line, prefix)
+	}
+
+	if flags&log.Ldate != 0 {
+		line = skip(line, len("2006/01/02 "))
+	}
+	if flags&(log.Ltime|log.Lmicroseconds) != 0 {
+		width := len("15:04:05 ")
+		if flags&log.Lmicroseconds != 0 {
+			width += len(".000000")
+		}
+		line = skip(line, width)
+	}
+	if flags&(log.Lshortfile|log.Llongfile) != 0 {
+		if i := strings.Index(line, ": "); i >= 0 {
+			line = line[i+2:]
+		}
+	}
+
+	if prefix != "" && flags&log.Lmsgprefix != 0 {
+		line = strings.TrimPrefix(line, prefix)
+	}
+	return line, strings.TrimSpace(prefix)
+}
+
+func skip(line string, n int) string {
+	if len(line) < n {
+		return line
+	}
+	return line[n:]
+}
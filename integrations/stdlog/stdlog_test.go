@@ -0,0 +1,123 @@
+package stdlog
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kart-io/logger"
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/integrations/internal/logtest"
+	"github.com/kart-io/logger/option"
+)
+
+func TestNewStdLogAt(t *testing.T) {
+	recorder := logtest.New()
+	std := NewStdLogAt(recorder, core.WarnLevel)
+
+	std.Printf("disk usage at %d%%", 91)
+	std.Println("second line")
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Level != core.WarnLevel || entries[0].Msg != "disk usage at 91%" {
+		t.Errorf("Unexpected first entry %+v", entries[0])
+	}
+	if entries[1].Msg != "second line" {
+		t.Errorf("Expected trailing newline to be trimmed, got %q", entries[1].Msg)
+	}
+}
+
+func TestStripHeader(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		prefix     string
+		flags      int
+		wantMsg    string
+		wantPrefix string
+	}{
+		{"no flags", "hello", "", 0, "hello", ""},
+		{"std flags", "2026/10/18 14:02:53 hello", "", log.LstdFlags, "hello", ""},
+		{"microseconds", "14:02:53.123456 hello", "", log.Ltime | log.Lmicroseconds, "hello", ""},
+		{"short file", "2026/10/18 main.go:12: hello: world", "", log.Ldate | log.Lshortfile, "hello: world", ""},
+		{"prefix", "[http] 2026/10/18 14:02:53 hello", "[http] ", log.LstdFlags, "hello", "[http]"},
+		{"msg prefix", "2026/10/18 14:02:53 [http] hello", "[http] ", log.LstdFlags | log.Lmsgprefix, "hello", "[http]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, prefix := stripHeader(tt.line, tt.prefix, tt.flags)
+			if msg != tt.wantMsg || prefix != tt.wantPrefix {
+				t.Errorf("stripHeader() = %q, %q, want %q, %q", msg, prefix, tt.wantMsg, tt.wantPrefix)
+			}
+		})
+	}
+}
+
+func TestRedirectStdLog(t *testing.T) {
+	originalFlags, originalPrefix, originalOutput := log.Flags(), log.Prefix(), log.Writer()
+
+	recorder := logtest.New()
+	restore := RedirectStdLogAt(recorder, core.ErrorLevel)
+
+	log.Print("redirected")
+	// Libraries that change the global flags afterwards must not duplicate timestamps
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	log.SetPrefix("[lib] ")
+	log.Print("with header")
+
+	restore()
+
+	entries := recorder.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Level != core.ErrorLevel || entries[0].Msg != "redirected" {
+		t.Errorf("Unexpected first entry %+v", entries[0])
+	}
+	if entries[1].Msg != "with header" || entries[1].Fields["prefix"] != "[lib]" {
+		t.Errorf("Expected header to be stripped, got %+v", entries[1])
+	}
+
+	if log.Flags() != originalFlags || log.Prefix() != originalPrefix || log.Writer() != originalOutput {
+		t.Error("Expected restore to reinstate the original flags, prefix and output")
+	}
+}
+
+func TestRedirectStdLog_ReportsCaller(t *testing.T) {
+	for _, engine := range []string{"zap", "slog"} {
+		t.Run(engine, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "app.log")
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.OutputPaths = []string{logFile}
+
+			coreLogger, err := logger.New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			restore := RedirectStdLog(coreLogger)
+			log.Printf("from std %s", "log")
+			restore()
+
+			data, err := os.ReadFile(logFile)
+			if err != nil {
+				t.Fatalf("Failed to read log file: %v", err)
+			}
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(strings.TrimSpace(string(data))), &entry); err != nil {
+				t.Fatalf("Failed to parse %q: %v", data, err)
+			}
+			if caller, _ := entry["caller"].(string); !strings.Contains(caller, "stdlog_test.go") {
+				t.Errorf("Expected caller in test file, got %q", caller)
+			}
+		})
+	}
+}
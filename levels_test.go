@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/option"
+)
+
+// TestNamedLevels_ConcurrentWithLogging changes levels the way the reloader
+// does, from its own goroutine, while other goroutines derive loggers and
+// log. Run with -race.
+func TestNamedLevels_ConcurrentWithLogging(t *testing.T) {
+	for _, engine := range []string{"slog", "zap"} {
+		t.Run(engine, func(t *testing.T) {
+			opt := option.DefaultLogOption()
+			opt.Engine = engine
+			opt.OutputPaths = []string{filepath.Join(t.TempDir(), "app.log")}
+
+			l, err := New(opt)
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+			db := l.Named("db")
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					l.(core.NamedLevelSetter).SetNamedLevels(core.InfoLevel, map[string]core.Level{"db": core.DebugLevel})
+					db.SetLevel(core.WarnLevel)
+					db.(core.LevelResetter).ResetLevel()
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					db.With("i", i).WithGroup("req").WithCallerSkip(0).Infow("concurrent", "n", i)
+					db.WithCtx(t.Context(), "i", i).Debugw("concurrent")
+				}
+			}()
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+// logLimitCalls makes the same calls against every engine; keeping them in
+// one function gives both engines the same caller.
+func logLimitCalls(l core.Logger) {
+	l.Infow("a message that is too long",
+		"body", strings.Repeat("x", 100),
+		"order", fields.Object("", fields.Object("customer", fields.String("id", "c-1"))),
+		"status", 200,
+		"extra", true,
+	)
+	l.Info("a plain message that is too long")
+	l.With("blob", strings.Repeat("y", 100)).InfoF("with")
+}
+
+func TestLimits_AcrossEngines(t *testing.T) {
+	var outputs [2][]map[string]interface{}
+	for i, engine := range []string{"slog", "zap"} {
+		logFile := filepath.Join(t.TempDir(), "app.log")
+		opt := option.DefaultLogOption()
+		opt.Engine = engine
+		opt.OutputPaths = []string{logFile}
+		opt.Limits = &option.LimitsOption{
+			MaxMessageLength: 10,
+			MaxValueLength:   8,
+			MaxFields:        3,
+			MaxDepth:         1,
+		}
+
+		l, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: failed to create logger: %v", engine, err)
+		}
+		logLimitCalls(l)
+
+		outputs[i] = readEncoderEntries(t, logFile, fields.TimestampField, time.Local)
+	}
+
+	slogJSON, _ := json.Marshal(outputs[0])
+	zapJSON, _ := json.Marshal(outputs[1])
+	if !bytes.Equal(slogJSON, zapJSON) {
+		t.Errorf("Engines disagree\nslog: %s\nzap:  %s", slogJSON, zapJSON)
+	}
+
+	entries := outputs[0]
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	fieldsEntry := entries[0]
+	if fieldsEntry[fields.MessageField] != "a message " {
+		t.Errorf("Message = %q, want it cut to 10 bytes", fieldsEntry[fields.MessageField])
+	}
+	if fieldsEntry["body"] != "xxxxxxxx" {
+		t.Errorf("body = %q, want it cut to 8 bytes", fieldsEntry["body"])
+	}
+	if order, _ := fieldsEntry["order"].(map[string]interface{}); order["customer"] != fields.DepthValue {
+		t.Errorf("order = %v, want customer replaced by %q", fieldsEntry["order"], fields.DepthValue)
+	}
+	if _, ok := fieldsEntry["extra"]; ok {
+		t.Error("Expected extra to be dropped beyond MaxFields")
+	}
+	want := map[string]interface{}{
+		"message": float64(26),
+		"values":  []interface{}{"body"},
+		"depth":   []interface{}{"order.customer"},
+		"fields":  float64(1),
+	}
+	if got := fieldsEntry[fields.TruncatedField]; !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", fields.TruncatedField, got, want)
+	}
+
+	plainEntry := entries[1]
+	if plainEntry[fields.MessageField] != "a plain me" {
+		t.Errorf("Plain message = %q, want it cut to 10 bytes", plainEntry[fields.MessageField])
+	}
+	want = map[string]interface{}{"message": float64(32)}
+	if got := plainEntry[fields.TruncatedField]; !reflect.DeepEqual(got, want) {
+		t.Errorf("Plain %s = %v, want %v", fields.TruncatedField, got, want)
+	}
+
+	withEntry := entries[2]
+	if withEntry["blob"] != "yyyyyyyy" {
+		t.Errorf("blob = %q, want the With field cut to 8 bytes", withEntry["blob"])
+	}
+}
+
+func TestLimits_RecordBytesAcrossEngines(t *testing.T) {
+	for _, engine := range []string{"slog", "zap"} {
+		logFile := filepath.Join(t.TempDir(), "app.log")
+		opt := option.DefaultLogOption()
+		opt.Engine = engine
+		opt.OutputPaths = []string{logFile}
+		opt.Limits = &option.LimitsOption{MaxRecordBytes: 64}
+
+		l, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: failed to create logger: %v", engine, err)
+		}
+		l.Infow("response", "status", 200, "body", strings.Repeat("z", 1<<20), "elapsed_ms", 12)
+
+		entries := readEncoderEntries(t, logFile, fields.TimestampField, time.Local)
+		entry := entries[0]
+		if _, ok := entry["body"]; ok {
+			t.Errorf("%s: expected body to be dropped", engine)
+		}
+		if entry["status"] != float64(200) || entry["elapsed_ms"] != float64(12) {
+			t.Errorf("%s: expected the fields that fit to be kept: %v", engine, entry)
+		}
+		want := map[string]interface{}{"record": []interface{}{"body"}}
+		if got := entry[fields.TruncatedField]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: %s = %v, want %v", engine, fields.TruncatedField, got, want)
+		}
+	}
+}
+
+func TestLimits_ContextCountsPerEntry(t *testing.T) {
+	for _, engine := range []string{"slog", "zap"} {
+		logFile := filepath.Join(t.TempDir(), "app.log")
+		opt := option.DefaultLogOption()
+		opt.Engine = engine
+		opt.OutputPaths = []string{logFile}
+		opt.Limits = &option.LimitsOption{MaxFields: 2, MaxRecordBytes: 100}
+
+		l, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: failed to create logger: %v", engine, err)
+		}
+		l.With("a", strings.Repeat("x", 80), "b", 1, "c", 2).
+			Infow("m", "d", strings.Repeat("y", 80), "e", 1, "f", 2)
+
+		entry := readEncoderEntries(t, logFile, fields.TimestampField, time.Local)[0]
+		for _, key := range []string{"c", "d", "e", "f"} {
+			if _, ok := entry[key]; ok {
+				t.Errorf("%s: expected %s to be dropped once the context fills the budget", engine, key)
+			}
+		}
+		if entry["a"] == nil || entry["b"] != float64(1) {
+			t.Errorf("%s: expected the context fields that fit to be kept: %v", engine, entry)
+		}
+		want := map[string]interface{}{"fields": float64(4)}
+		if got := entry[fields.TruncatedField]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: %s = %v, want one merged marker %v", engine, fields.TruncatedField, got, want)
+		}
+		for key := range entry {
+			if strings.HasPrefix(key, fields.TruncatedField+"_") {
+				t.Errorf("%s: unexpected second marker %s", engine, key)
+			}
+		}
+	}
+}
+
+// countingMarshaler counts how often it is marshaled.
+type countingMarshaler struct{ calls *int }
+
+func (m countingMarshaler) LogObject() []fields.Field {
+	*m.calls++
+	return []fields.Field{fields.String("id", "c-1")}
+}
+
+func TestLimits_SkippedForDisabledLevels(t *testing.T) {
+	for _, engine := range []string{"slog", "zap"} {
+		opt := option.DefaultLogOption()
+		opt.Engine = engine
+		opt.OutputPaths = []string{filepath.Join(t.TempDir(), "app.log")}
+		opt.Limits = &option.LimitsOption{MaxValueLength: 8}
+
+		l, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: failed to create logger: %v", engine, err)
+		}
+
+		calls := 0
+		l.Debugw("disabled", "order", countingMarshaler{&calls})
+		l.DebugF("disabled", fields.ObjectOf("order", countingMarshaler{&calls}))
+		if calls != 0 {
+			t.Errorf("%s: expected disabled entries not to be processed, marshaled %d times", engine, calls)
+		}
+
+		l.Infow("enabled", "order", countingMarshaler{&calls})
+		if calls != 1 {
+			t.Errorf("%s: expected enabled entry to be marshaled once, got %d", engine, calls)
+		}
+	}
+}
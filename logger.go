@@ -3,12 +3,18 @@ package logger
 import (
 	"github.com/kart-io/logger/core"
 	"github.com/kart-io/logger/factory"
+	"github.com/kart-io/logger/fields"
 	"github.com/kart-io/logger/option"
 )
 
 // Global logger instance
 var global core.Logger
 
+// typedGlobal is the global logger skipping one more caller frame. The typed
+// field functions below log through it, so engines can report their caller
+// without searching the stack for this file.
+var typedGlobal core.Logger
+
 // New creates a new logger with the provided configuration.
 func New(opt *option.LogOption) (core.Logger, error) {
 	f := factory.NewLoggerFactory(opt)
@@ -23,6 +29,10 @@ func NewWithDefaults() (core.Logger, error) {
 // SetGlobal sets the global logger instance.
 func SetGlobal(logger core.Logger) {
 	global = logger
+	typedGlobal = nil
+	if logger != nil {
+		typedGlobal = logger.WithCallerSkip(1)
+	}
 }
 
 // Global returns the global logger instance.
@@ -35,11 +45,17 @@ func Global() core.Logger {
 			// This should not happen with valid default config
 			panic("failed to create default logger: " + err.Error())
 		}
-		global = logger
+		SetGlobal(logger)
 	}
 	return global
 }
 
+// typed returns the global logger used by the typed field functions.
+func typed() core.Logger {
+	Global()
+	return typedGlobal
+}
+
 // Package-level convenience functions using the global logger
 
 // Debug logs a debug message using the global logger.
@@ -117,7 +133,37 @@ func Fatalw(msg string, keysAndValues ...interface{}) {
 	Global().Fatalw(msg, keysAndValues...)
 }
 
+// DebugF logs a debug message with typed fields using the global logger.
+func DebugF(msg string, fs ...fields.Field) {
+	typed().DebugF(msg, fs...)
+}
+
+// InfoF logs an info message with typed fields using the global logger.
+func InfoF(msg string, fs ...fields.Field) {
+	typed().InfoF(msg, fs...)
+}
+
+// WarnF logs a warning message with typed fields using the global logger.
+func WarnF(msg string, fs ...fields.Field) {
+	typed().WarnF(msg, fs...)
+}
+
+// ErrorF logs an error message with typed fields using the global logger.
+func ErrorF(msg string, fs ...fields.Field) {
+	typed().ErrorF(msg, fs...)
+}
+
+// FatalF logs a fatal message with typed fields using the global logger.
+func FatalF(msg string, fs ...fields.Field) {
+	typed().FatalF(msg, fs...)
+}
+
 // With creates a child logger with the specified key-value pairs using the global logger.
 func With(keysAndValues ...interface{}) core.Logger {
 	return Global().With(keysAndValues...)
-}
\ No newline at end of file
+}
+
+// Named creates a named child logger using the global logger.
+func Named(name string) core.Logger {
+	return Global().Named(name)
+}
@@ -1,8 +1,12 @@
 package logger
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/kart-io/logger/fields"
 	"github.com/kart-io/logger/option"
 )
 
@@ -147,4 +151,29 @@ func TestNewWithCustomOption(t *testing.T) {
 	if logger == nil {
 		t.Error("Expected logger to be created successfully")
 	}
-}
\ No newline at end of file
+}
+func TestPackageLevelTypedFunctions_Caller(t *testing.T) {
+	defer SetGlobal(nil)
+
+	for _, engine := range []string{"slog", "zap"} {
+		logFile := filepath.Join(t.TempDir(), "app.log")
+		opt := option.DefaultLogOption()
+		opt.Engine = engine
+		opt.OutputPaths = []string{logFile}
+
+		l, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: failed to create logger: %v", engine, err)
+		}
+		SetGlobal(l)
+
+		InfoF("global", fields.Int("n", 1))
+		l.InfoF("direct", fields.Int("n", 2))
+
+		for _, entry := range readEncoderEntries(t, logFile, fields.TimestampField, time.Local) {
+			if caller, _ := entry[fields.CallerField].(string); !strings.HasPrefix(caller, "logger_test.go:") {
+				t.Errorf("%s: %v logged with caller %q, want logger_test.go", engine, entry[fields.MessageField], caller)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kart-io/logger/option"
+)
+
+func TestFieldNaming_AcrossEngines(t *testing.T) {
+	tests := []struct {
+		scheme  string
+		mapping map[string]string
+		keys    []string
+	}{
+		{"default", nil, []string{"timestamp", "level", "message", "caller", "logger", "trace_id", "error", "user_id"}},
+		{"ecs", nil, []string{"@timestamp", "log.level", "message", "log.origin.file.name", "log.logger", "trace.id", "error.message", "user.id"}},
+		{"otel", nil, []string{"timestamp", "severity_text", "body", "code.filepath", "otel.scope.name", "trace_id", "exception.message", "enduser.id"}},
+		{"gcp", nil, []string{"time", "severity", "message", "caller", "logger", "logging.googleapis.com/trace", "error", "user_id"}},
+		{"datadog", nil, []string{"timestamp", "status", "message", "caller", "logger.name", "dd.trace_id", "error.message", "usr.id"}},
+		{"ecs", map[string]string{"user_id": "customer.id"}, []string{"@timestamp", "trace.id", "customer.id"}},
+	}
+
+	for _, tt := range tests {
+		for _, engine := range []string{"slog", "zap"} {
+			t.Run(tt.scheme+"/"+engine, func(t *testing.T) {
+				logFile := filepath.Join(t.TempDir(), "app.log")
+				opt := option.DefaultLogOption()
+				opt.Engine = engine
+				opt.OutputPaths = []string{logFile}
+				opt.FieldNaming = &option.FieldNamingOption{Scheme: tt.scheme, Mapping: tt.mapping}
+
+				l, err := New(opt)
+				if err != nil {
+					t.Fatalf("Failed to create logger: %v", err)
+				}
+				l.Named("payments").Warnw("charge declined",
+					"traceId", "abc",
+					"error", "card expired",
+					"user_id", "u-1",
+				)
+
+				data, err := os.ReadFile(logFile)
+				if err != nil {
+					t.Fatalf("Failed to read log file: %v", err)
+				}
+				var entry map[string]interface{}
+				if err := json.Unmarshal(data, &entry); err != nil {
+					t.Fatalf("Invalid JSON %q: %v", data, err)
+				}
+				for _, key := range tt.keys {
+					if _, ok := entry[key]; !ok {
+						t.Errorf("Expected key %q in %s", key, data)
+					}
+				}
+			})
+		}
+	}
+}
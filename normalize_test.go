@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kart-io/logger/core"
+	lerrors "github.com/kart-io/logger/errors"
+	"github.com/kart-io/logger/fields"
+	"github.com/kart-io/logger/option"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// volatileKeys differ between engines or between runs and are left out of
+// the golden comparison.
+var volatileKeys = map[string]bool{
+	fields.TimestampField:  true,
+	fields.LevelField:      true,
+	fields.MessageField:    true,
+	fields.CallerField:     true,
+	fields.StacktraceField: true,
+	fields.ErrorStackField: true,
+	"engine":               true,
+}
+
+type goldenOrder struct {
+	ID    string
+	Items goldenItems
+	Next  *goldenOrder
+}
+
+func (o *goldenOrder) LogObject() []fields.Field {
+	return []fields.Field{
+		fields.String("id", o.ID),
+		fields.ArrayOf("items", o.Items),
+		fields.Any("next", o.Next),
+	}
+}
+
+type goldenItems []string
+
+func (items goldenItems) LogArray() []fields.Field {
+	elems := make([]fields.Field, len(items))
+	for i, item := range items {
+		elems[i] = fields.Object("", fields.String("sku", item))
+	}
+	return elems
+}
+
+var normalizeCases = []struct {
+	name string
+	log  func(l core.Logger)
+}{
+	{"pairs", func(l core.Logger) {
+		l.Infow("pairs", "user", "alice", "count", 42, "traceId", "abc")
+	}},
+	{"dangling_key", func(l core.Logger) {
+		l.Infow("dangling", "user", "alice", "dangling")
+	}},
+	{"non_string_key", func(l core.Logger) {
+		l.Infow("non-string key", 42, "value", "user", "alice")
+	}},
+	{"mixed", func(l core.Logger) {
+		l.Infow("mixed",
+			fields.String("user", "alice"),
+			"count", 3,
+			slog.Int("attempt", 2),
+			slog.Group("request", slog.String("method", "GET"), slog.Int("status", 200)),
+			fields.Err(errors.New("boom")),
+			fields.Err(nil),
+		)
+	}},
+	{"error_chain", func(l core.Logger) {
+		cause := lerrors.NewError(lerrors.ConfigError, "loader", "missing file", os.ErrNotExist)
+		l.Infow("error chain", "error", fmt.Errorf("load config: %w", fields.WithStack(cause)))
+	}},
+	{"error_join", func(l core.Logger) {
+		l.InfoF("error join", fields.NamedErr("batch_error", errors.Join(
+			errors.New("item 1 failed"),
+			fmt.Errorf("item 2 failed: %w", errors.New("timeout")),
+		)))
+	}},
+	{"marshalers", func(l core.Logger) {
+		order := &goldenOrder{ID: "o-1", Items: goldenItems{"a", "b"}}
+		order.Next = &goldenOrder{ID: "o-2", Next: order}
+		l.Infow("marshalers", "order", order, "items", goldenItems{"c"})
+	}},
+	{"groups", func(l core.Logger) {
+		l.With("service", "billing").WithGroup("request").With("method", "GET").
+			WithGroup("client").Infow("grouped", "ip", "10.0.0.1", "traceId", "abc")
+	}},
+	{"group_without_fields", func(l core.Logger) {
+		l.With("user", "alice").WithGroup("request").WithGroup("client").Infow("empty groups")
+	}},
+	{"group_plain", func(l core.Logger) {
+		l.WithGroup("request").With("id", 7).WithGroup("").Info("plain")
+	}},
+	{"with", func(l core.Logger) {
+		l.With("service", "billing", true).Infow("with", "user", "alice")
+	}},
+}
+
+func TestNormalize_GoldenAcrossEngines(t *testing.T) {
+	got := map[string][][2]interface{}{}
+	for _, engine := range []string{"slog", "zap"} {
+		for _, tc := range normalizeCases {
+			pairs := logCase(t, engine, tc.log)
+			if prev, ok := got[tc.name]; ok {
+				if !pairsEqual(prev, pairs) {
+					t.Errorf("%s: engines disagree\nslog: %v\nzap:  %v", tc.name, prev, pairs)
+				}
+				continue
+			}
+			got[tc.name] = pairs
+		}
+	}
+
+	golden := filepath.Join("testdata", "normalize.golden")
+	actual, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual = append(actual, '\n')
+
+	if *updateGolden {
+		if err := os.WriteFile(golden, actual, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Failed to read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(want, actual) {
+		t.Errorf("Output does not match %s (run with -update to accept)\ngot:\n%s", golden, actual)
+	}
+}
+
+// logCase runs log against a JSON logger for engine and returns the
+// non-volatile top-level fields of the single entry in order.
+func logCase(t *testing.T, engine string, log func(l core.Logger)) [][2]interface{} {
+	t.Helper()
+
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	opt := option.DefaultLogOption()
+	opt.Engine = engine
+	opt.OutputPaths = []string{logFile}
+
+	l, err := New(opt)
+	if err != nil {
+		t.Fatalf("Failed to create %s logger: %v", engine, err)
+	}
+	log(l)
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		t.Fatalf("%s: invalid JSON %q: %v", engine, data, err)
+	}
+	var pairs [][2]interface{}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			t.Fatalf("%s: invalid JSON %q: %v", engine, data, err)
+		}
+		var value interface{}
+		if err := dec.Decode(&value); err != nil {
+			t.Fatalf("%s: invalid JSON %q: %v", engine, data, err)
+		}
+		if k := key.(string); !volatileKeys[k] {
+			pairs = append(pairs, [2]interface{}{k, value})
+		}
+	}
+	return pairs
+}
+
+func pairsEqual(a, b [][2]interface{}) bool {
+	ja, _ := json.Marshal(a)
+	jb, _ := json.Marshal(b)
+	return bytes.Equal(ja, jb)
+}
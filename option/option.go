@@ -1,9 +1,12 @@
 package option
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 	"github.com/spf13/pflag"
 )
 
@@ -15,6 +18,11 @@ type LogOption struct {
 	// Level sets the minimum logging level
 	Level string `json:"level" mapstructure:"level"`
 
+	// Levels sets per-component levels for named loggers, matched by longest
+	// dot-separated prefix (e.g. "payments" covers "payments.refunds").
+	// The "*" entry overrides Level as the default.
+	Levels map[string]string `json:"levels" mapstructure:"levels"`
+
 	// Format specifies output format ("json" or "console")
 	Format string `json:"format" mapstructure:"format"`
 
@@ -33,6 +41,78 @@ type LogOption struct {
 
 	// DisableStacktrace disables automatic stacktrace capture
 	DisableStacktrace bool `json:"disable_stacktrace" mapstructure:"disable_stacktrace"`
+
+	// FieldNaming selects the field names written by the engines and OTLP
+	FieldNaming *FieldNamingOption `json:"field_naming" mapstructure:"field_naming"`
+
+	// Encoder controls how both engines encode times, levels, callers and durations
+	Encoder *EncoderOption `json:"encoder" mapstructure:"encoder"`
+
+	// Limits bounds the size of each entry in both engines and OTLP export
+	Limits *LimitsOption `json:"limits" mapstructure:"limits"`
+
+	// DuplicateKeys decides which field is written when With and the call
+	// site use the same key: "last" (default), "first" or "suffix"
+	DuplicateKeys string `json:"duplicate_keys" mapstructure:"duplicate_keys"`
+}
+
+// LimitsOption bounds the size of log entries; zero values are unlimited.
+// Entries that exceed a limit are cut and carry a fields.TruncatedField
+// object describing what was cut.
+type LimitsOption struct {
+	// MaxMessageLength is the maximum message length in bytes
+	MaxMessageLength int `json:"max_message_length" mapstructure:"max_message_length"`
+
+	// MaxValueLength is the maximum length in bytes of a string, error or
+	// encoded value, at any depth
+	MaxValueLength int `json:"max_value_length" mapstructure:"max_value_length"`
+
+	// MaxFields is the maximum number of fields per entry
+	MaxFields int `json:"max_fields" mapstructure:"max_fields"`
+
+	// MaxDepth is the maximum nesting of objects and arrays
+	MaxDepth int `json:"max_depth" mapstructure:"max_depth"`
+
+	// MaxRecordBytes is the maximum size of the message and fields, and of
+	// each exported OTLP log record
+	MaxRecordBytes int `json:"max_record_bytes" mapstructure:"max_record_bytes"`
+}
+
+// EncoderOption controls entry encoding; empty values keep the defaults.
+type EncoderOption struct {
+	// TimeLayout is "rfc3339nano" (default), "rfc3339", "epoch_millis",
+	// "epoch_nanos" or a Go time layout
+	TimeLayout string `json:"time_layout" mapstructure:"time_layout"`
+
+	// TimeZone is "local" (default) or "utc"
+	TimeZone string `json:"time_zone" mapstructure:"time_zone"`
+
+	// LevelCase is "lower" (default) or "upper"
+	LevelCase string `json:"level_case" mapstructure:"level_case"`
+
+	// Caller is "short" (default), "full" or "function"
+	Caller string `json:"caller" mapstructure:"caller"`
+
+	// Duration is "seconds" (default), "millis", "nanos" or "string"
+	Duration string `json:"duration" mapstructure:"duration"`
+
+	// Key names for the built-in entry fields; they override FieldNaming
+	TimeKey       string `json:"time_key" mapstructure:"time_key"`
+	LevelKey      string `json:"level_key" mapstructure:"level_key"`
+	MessageKey    string `json:"message_key" mapstructure:"message_key"`
+	CallerKey     string `json:"caller_key" mapstructure:"caller_key"`
+	NameKey       string `json:"name_key" mapstructure:"name_key"`
+	StacktraceKey string `json:"stacktrace_key" mapstructure:"stacktrace_key"`
+}
+
+// FieldNamingOption selects a field naming scheme and per-field overrides.
+type FieldNamingOption struct {
+	// Scheme is "default", "ecs", "otel", "gcp" or "datadog"
+	Scheme string `json:"scheme" mapstructure:"scheme"`
+
+	// Mapping overrides the names of individual fields, keyed by standard
+	// name, alias or custom field name
+	Mapping map[string]string `json:"mapping" mapstructure:"mapping"`
 }
 
 // OTLPOption contains OTLP-specific configuration.
@@ -43,6 +123,10 @@ type OTLPOption struct {
 	Timeout  time.Duration     `json:"timeout" mapstructure:"timeout"`
 	Headers  map[string]string `json:"headers" mapstructure:"headers"`
 	Insecure bool              `json:"insecure" mapstructure:"insecure"`
+
+	// FlattenGroups exports grouped fields as dotted keys ("request.method")
+	// instead of nested KvlistValue attributes
+	FlattenGroups bool `json:"flatten_groups" mapstructure:"flatten_groups"`
 }
 
 // DefaultLogOption returns a configuration with sensible defaults.
@@ -67,12 +151,39 @@ func DefaultLogOption() *LogOption {
 func (opt *LogOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&opt.Engine, "engine", "slog", "Logging engine (zap|slog)")
 	fs.StringVar(&opt.Level, "level", "INFO", "Log level (DEBUG|INFO|WARN|ERROR|FATAL)")
+	fs.StringToStringVar(&opt.Levels, "levels", nil, "Per-component log levels (e.g. payments=DEBUG,*=INFO)")
 	fs.StringVar(&opt.Format, "format", "json", "Log format (json|console)")
 	fs.StringSliceVar(&opt.OutputPaths, "output-paths", []string{"stdout"}, "Output paths for logs")
 	fs.StringVar(&opt.OTLPEndpoint, "otlp-endpoint", "", "OTLP endpoint URL")
 	fs.BoolVar(&opt.Development, "development", false, "Enable development mode")
 	fs.BoolVar(&opt.DisableCaller, "disable-caller", false, "Disable caller detection")
 	fs.BoolVar(&opt.DisableStacktrace, "disable-stacktrace", false, "Disable stacktrace capture")
+	fs.StringVar(&opt.DuplicateKeys, "duplicate-keys", "", "Duplicate key policy (last|first|suffix)")
+
+	if opt.Limits == nil {
+		opt.Limits = &LimitsOption{}
+	}
+	fs.IntVar(&opt.Limits.MaxMessageLength, "limits.max-message-length", 0, "Maximum message length in bytes (0 for unlimited)")
+	fs.IntVar(&opt.Limits.MaxValueLength, "limits.max-value-length", 0, "Maximum field value length in bytes (0 for unlimited)")
+	fs.IntVar(&opt.Limits.MaxFields, "limits.max-fields", 0, "Maximum fields per entry (0 for unlimited)")
+	fs.IntVar(&opt.Limits.MaxDepth, "limits.max-depth", 0, "Maximum object and array nesting (0 for unlimited)")
+	fs.IntVar(&opt.Limits.MaxRecordBytes, "limits.max-record-bytes", 0, "Maximum entry size in bytes (0 for unlimited)")
+
+	if opt.FieldNaming == nil {
+		opt.FieldNaming = &FieldNamingOption{}
+	}
+	fs.StringVar(&opt.FieldNaming.Scheme, "field-naming", "", "Field naming scheme (default|ecs|otel|gcp|datadog)")
+	fs.StringToStringVar(&opt.FieldNaming.Mapping, "field-mapping", nil, "Field name overrides (e.g. user_id=usr.id)")
+
+	// Encoder options
+	if opt.Encoder == nil {
+		opt.Encoder = &EncoderOption{}
+	}
+	fs.StringVar(&opt.Encoder.TimeLayout, "encoder.time-layout", "", "Time layout (rfc3339nano|rfc3339|epoch_millis|epoch_nanos or a Go layout)")
+	fs.StringVar(&opt.Encoder.TimeZone, "encoder.time-zone", "", "Time zone for entry times (local|utc)")
+	fs.StringVar(&opt.Encoder.LevelCase, "encoder.level-case", "", "Level casing (lower|upper)")
+	fs.StringVar(&opt.Encoder.Caller, "encoder.caller", "", "Caller format (short|full|function)")
+	fs.StringVar(&opt.Encoder.Duration, "encoder.duration", "", "Duration format (seconds|millis|nanos|string)")
 
 	// OTLP nested options
 	if opt.OTLP == nil {
@@ -81,6 +192,7 @@ func (opt *LogOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&opt.OTLP.Endpoint, "otlp.endpoint", "", "OTLP nested endpoint URL")
 	fs.StringVar(&opt.OTLP.Protocol, "otlp.protocol", "grpc", "OTLP protocol (grpc|http)")
 	fs.DurationVar(&opt.OTLP.Timeout, "otlp.timeout", 10*time.Second, "OTLP timeout duration")
+	fs.BoolVar(&opt.OTLP.FlattenGroups, "otlp.flatten-groups", false, "Export grouped fields as dotted keys instead of nested attributes")
 }
 
 // Validate checks the configuration for consistency and applies intelligent defaults.
@@ -89,6 +201,21 @@ func (opt *LogOption) Validate() error {
 	if _, err := core.ParseLevel(opt.Level); err != nil {
 		return err
 	}
+	if _, err := opt.ParseLevels(); err != nil {
+		return err
+	}
+	if _, err := opt.FieldMapper(); err != nil {
+		return err
+	}
+	if _, err := opt.EncoderConfig(); err != nil {
+		return err
+	}
+	if _, err := opt.RecordLimits(); err != nil {
+		return err
+	}
+	if _, err := opt.KeyResolver(); err != nil {
+		return err
+	}
 
 	// Apply OTLP intelligent configuration resolution
 	opt.resolveOTLPConfig()
@@ -147,6 +274,19 @@ func (opt *LogOption) resolveOTLPConfig() {
 	}
 }
 
+// ParseLevels parses the per-component levels.
+func (opt *LogOption) ParseLevels() (map[string]core.Level, error) {
+	levels := make(map[string]core.Level, len(opt.Levels))
+	for name, text := range opt.Levels {
+		level, err := core.ParseLevel(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid level for %q: %w", name, err)
+		}
+		levels[name] = level
+	}
+	return levels, nil
+}
+
 // IsOTLPEnabled returns true if OTLP is enabled after configuration resolution.
 func (opt *LogOption) IsOTLPEnabled() bool {
 	return opt.OTLP != nil && opt.OTLP.Enabled != nil && *opt.OTLP.Enabled && opt.OTLP.Endpoint != ""
@@ -155,4 +295,146 @@ func (opt *LogOption) IsOTLPEnabled() bool {
 // IsEnabled returns true if OTLP is enabled.
 func (opt *OTLPOption) IsEnabled() bool {
 	return opt != nil && opt.Enabled != nil && *opt.Enabled && opt.Endpoint != ""
-}
\ No newline at end of file
+}
+
+// FieldMapper builds the field mapper for the configured naming scheme and
+// encoder key names.
+func (opt *LogOption) FieldMapper() (*fields.FieldMapper, error) {
+	scheme := fields.DefaultScheme
+	mapping := map[string]string{}
+	if opt.FieldNaming != nil {
+		var err error
+		if scheme, err = fields.ParseNamingScheme(opt.FieldNaming.Scheme); err != nil {
+			return nil, err
+		}
+		for key, name := range opt.FieldNaming.Mapping {
+			mapping[key] = name
+		}
+	}
+	if enc := opt.Encoder; enc != nil {
+		for key, name := range map[string]string{
+			fields.TimestampField:  enc.TimeKey,
+			fields.LevelField:      enc.LevelKey,
+			fields.MessageField:    enc.MessageKey,
+			fields.CallerField:     enc.CallerKey,
+			fields.LoggerField:     enc.NameKey,
+			fields.StacktraceField: enc.StacktraceKey,
+		} {
+			if name != "" {
+				mapping[key] = name
+			}
+		}
+	}
+	return fields.NewFieldMapperWithScheme(scheme, mapping)
+}
+
+// EncoderConfig builds the encoder configuration shared by both engines.
+func (opt *LogOption) EncoderConfig() (*fields.EncoderConfig, error) {
+	cfg := fields.DefaultEncoderConfig()
+	enc := opt.Encoder
+	if enc == nil {
+		return cfg, nil
+	}
+
+	switch strings.ToLower(enc.TimeLayout) {
+	case "", "rfc3339nano":
+		cfg.TimeLayout = time.RFC3339Nano
+	case "rfc3339":
+		cfg.TimeLayout = time.RFC3339
+	case fields.EpochMillisTimeLayout:
+		cfg.TimeLayout = fields.EpochMillisTimeLayout
+	case fields.EpochNanosTimeLayout:
+		cfg.TimeLayout = fields.EpochNanosTimeLayout
+	default:
+		cfg.TimeLayout = enc.TimeLayout
+	}
+
+	switch strings.ToLower(enc.TimeZone) {
+	case "", "local":
+		cfg.UTC = false
+	case "utc":
+		cfg.UTC = true
+	default:
+		return nil, fmt.Errorf("invalid encoder time zone %q", enc.TimeZone)
+	}
+
+	switch strings.ToLower(enc.LevelCase) {
+	case "", "lower":
+		cfg.LevelFormatter = fields.LowercaseLevelFormatter
+	case "upper":
+		cfg.LevelFormatter = fields.UppercaseLevelFormatter
+	default:
+		return nil, fmt.Errorf("invalid encoder level case %q", enc.LevelCase)
+	}
+
+	switch strings.ToLower(enc.Caller) {
+	case "", "short":
+		cfg.CallerFormat = fields.ShortCallerFormatter
+	case "full":
+		cfg.CallerFormat = fields.FullCallerFormatter
+	case "function":
+		cfg.CallerFormat = fields.FunctionCallerFormatter
+	default:
+		return nil, fmt.Errorf("invalid encoder caller format %q", enc.Caller)
+	}
+
+	switch strings.ToLower(enc.Duration) {
+	case "", "seconds":
+		cfg.DurationFormat = fields.SecondsDurationFormatter
+	case "millis":
+		cfg.DurationFormat = fields.MillisDurationFormatter
+	case "nanos":
+		cfg.DurationFormat = fields.NanosDurationFormatter
+	case "string":
+		cfg.DurationFormat = fields.StringDurationFormatter
+	default:
+		return nil, fmt.Errorf("invalid encoder duration format %q", enc.Duration)
+	}
+
+	return cfg, nil
+}
+
+// RecordLimits builds the entry size limits shared by both engines and OTLP
+// export. It returns nil when no limit is set.
+func (opt *LogOption) RecordLimits() (*fields.Limits, error) {
+	if opt.Limits == nil {
+		return nil, nil
+	}
+	limits := &fields.Limits{
+		MaxMessageLength: opt.Limits.MaxMessageLength,
+		MaxValueLength:   opt.Limits.MaxValueLength,
+		MaxFields:        opt.Limits.MaxFields,
+		MaxDepth:         opt.Limits.MaxDepth,
+		MaxRecordBytes:   opt.Limits.MaxRecordBytes,
+	}
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
+	if !limits.Enabled() {
+		return nil, nil
+	}
+	return limits, nil
+}
+
+// KeyResolver builds the duplicate key resolver shared by both engines. The
+// keys the engines write themselves, including the "engine" field, are
+// reserved so that user fields never replace or repeat them.
+func (opt *LogOption) KeyResolver() (*fields.KeyResolver, error) {
+	policy, err := fields.ParseDuplicateKeyPolicy(opt.DuplicateKeys)
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := opt.FieldMapper()
+	if err != nil {
+		return nil, err
+	}
+	return fields.NewKeyResolver(policy,
+		mapper.StandardName(fields.TimestampField),
+		mapper.StandardName(fields.LevelField),
+		mapper.StandardName(fields.MessageField),
+		mapper.StandardName(fields.CallerField),
+		mapper.StandardName(fields.LoggerField),
+		mapper.StandardName(fields.StacktraceField),
+		"engine",
+	), nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kart-io/logger/fields"
 	"github.com/spf13/pflag"
 )
 
@@ -98,6 +99,56 @@ func TestLogOption_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid component level",
+			opt: &LogOption{
+				Engine: "slog",
+				Level:  "INFO",
+				Levels: map[string]string{"payments": "LOUD"},
+				Format: "json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid field naming scheme",
+			opt: &LogOption{
+				Engine:      "slog",
+				Level:       "INFO",
+				Format:      "json",
+				FieldNaming: &FieldNamingOption{Scheme: "logfmt"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid encoder option",
+			opt: &LogOption{
+				Engine:  "slog",
+				Level:   "INFO",
+				Format:  "json",
+				Encoder: &EncoderOption{Caller: "relative"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative limit",
+			opt: &LogOption{
+				Engine: "slog",
+				Level:  "INFO",
+				Format: "json",
+				Limits: &LimitsOption{MaxFields: -1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid duplicate key policy",
+			opt: &LogOption{
+				Engine:        "slog",
+				Level:         "INFO",
+				Format:        "json",
+				DuplicateKeys: "merge",
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid engine gets corrected",
 			opt: &LogOption{
@@ -299,4 +350,104 @@ func TestLogOption_StructTags(t *testing.T) {
 // boolPtr returns a pointer to a bool value
 func boolPtr(b bool) *bool {
 	return &b
-}
\ No newline at end of file
+}
+
+func TestLogOption_EncoderConfig(t *testing.T) {
+	opt := &LogOption{Encoder: &EncoderOption{
+		TimeLayout: "EPOCH_MILLIS",
+		TimeZone:   "utc",
+		LevelCase:  "upper",
+		Caller:     "function",
+		Duration:   "string",
+	}}
+
+	cfg, err := opt.EncoderConfig()
+	if err != nil {
+		t.Fatalf("EncoderConfig() error = %v", err)
+	}
+	if cfg.TimeLayout != fields.EpochMillisTimeLayout || !cfg.UTC ||
+		cfg.LevelFormatter != fields.UppercaseLevelFormatter ||
+		cfg.CallerFormat != fields.FunctionCallerFormatter ||
+		cfg.DurationFormat != fields.StringDurationFormatter {
+		t.Errorf("EncoderConfig() = %+v", cfg)
+	}
+
+	opt.Encoder = &EncoderOption{TimeLayout: "2006-01-02 15:04:05"}
+	if cfg, _ := opt.EncoderConfig(); cfg.TimeLayout != "2006-01-02 15:04:05" {
+		t.Errorf("custom layout = %q", cfg.TimeLayout)
+	}
+
+	for _, enc := range []*EncoderOption{
+		{TimeZone: "mars"},
+		{LevelCase: "title"},
+		{Caller: "relative"},
+		{Duration: "hours"},
+	} {
+		opt.Encoder = enc
+		if _, err := opt.EncoderConfig(); err == nil {
+			t.Errorf("EncoderConfig(%+v) expected error", enc)
+		}
+	}
+}
+
+func TestLogOption_RecordLimits(t *testing.T) {
+	opt := &LogOption{}
+	if limits, err := opt.RecordLimits(); limits != nil || err != nil {
+		t.Errorf("RecordLimits() = %+v, %v; want nil without limits", limits, err)
+	}
+
+	opt.Limits = &LimitsOption{}
+	if limits, err := opt.RecordLimits(); limits != nil || err != nil {
+		t.Errorf("RecordLimits() = %+v, %v; want nil for zero limits", limits, err)
+	}
+
+	opt.Limits = &LimitsOption{MaxMessageLength: 1024, MaxRecordBytes: 1 << 20}
+	limits, err := opt.RecordLimits()
+	if err != nil {
+		t.Fatalf("RecordLimits() error = %v", err)
+	}
+	if want := (&fields.Limits{MaxMessageLength: 1024, MaxRecordBytes: 1 << 20}); *limits != *want {
+		t.Errorf("RecordLimits() = %+v, want %+v", limits, want)
+	}
+}
+
+func TestLogOption_KeyResolver(t *testing.T) {
+	opt := &LogOption{
+		DuplicateKeys: "first",
+		Encoder:       &EncoderOption{MessageKey: "msg"},
+	}
+
+	resolver, err := opt.KeyResolver()
+	if err != nil {
+		t.Fatalf("KeyResolver() error = %v", err)
+	}
+	if got := resolver.Policy(); got != fields.FirstKeyWins {
+		t.Errorf("Policy() = %q, want %q", got, fields.FirstKeyWins)
+	}
+	got := resolver.Resolve([]string{"msg", "engine", "user_id", "user_id"})
+	want := []string{"msg_2", "engine_2", "user_id", ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve() = %q, want %q", got, want)
+	}
+}
+
+func TestLogOption_FieldMapper_EncoderKeys(t *testing.T) {
+	opt := &LogOption{
+		FieldNaming: &FieldNamingOption{Scheme: "ecs"},
+		Encoder:     &EncoderOption{MessageKey: "msg", LevelKey: "severity"},
+	}
+
+	mapper, err := opt.FieldMapper()
+	if err != nil {
+		t.Fatalf("FieldMapper() error = %v", err)
+	}
+	for in, want := range map[string]string{
+		fields.MessageField:   "msg",
+		fields.LevelField:     "severity",
+		fields.TimestampField: "@timestamp",
+	} {
+		if got := mapper.StandardName(in); got != want {
+			t.Errorf("StandardName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
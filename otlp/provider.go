@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
@@ -15,18 +17,32 @@ import (
 	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
 	"github.com/kart-io/logger/option"
 )
 
 // LoggerProvider manages the OTLP logs client for sending logs.
 type LoggerProvider struct {
-	client   *OTLPClient
-	resource *resourcev1.Resource
+	client        *OTLPClient
+	resource      *resourcev1.Resource
+	flattenGroups bool
+	limits        *fields.Limits
+
+	// levelKey, timeKey and messageKey name the attributes createLogRecord
+	// adds; empty keys use the VictoriaLogs defaults
+	levelKey   string
+	timeKey    string
+	messageKey string
 }
 
+// recordAttributes is the number of attributes createLogRecord adds before
+// the user attributes: level, @timestamp and _msg.
+const recordAttributes = 3
+
 // OTLPClient handles both gRPC and HTTP OTLP logs export.
 type OTLPClient struct {
 	endpoint string
@@ -85,11 +101,58 @@ func NewLoggerProvider(ctx context.Context, opt *option.OTLPOption) (*LoggerProv
 	}
 
 	return &LoggerProvider{
-		client:   client,
-		resource: resource,
+		client:        client,
+		resource:      resource,
+		flattenGroups: opt.FlattenGroups,
 	}, nil
 }
 
+// GroupAttributes places attributes under a logger's open groups, outermost
+// first. Groups become nested objects, exported as KvlistValue, or with
+// FlattenGroups dotted key prefixes such as "request.method".
+func (p *LoggerProvider) GroupAttributes(groups []string, attributes map[string]interface{}) map[string]interface{} {
+	if len(groups) == 0 || len(attributes) == 0 {
+		return attributes
+	}
+
+	if p.flattenGroups {
+		prefix := strings.Join(groups, ".") + "."
+		dotted := make(map[string]interface{}, len(attributes))
+		for key, value := range attributes {
+			dotted[prefix+key] = value
+		}
+		return dotted
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		attributes = map[string]interface{}{groups[i]: attributes}
+	}
+	return attributes
+}
+
+// SetLimits bounds the size of exported log records. The engines apply the
+// limits to each entry before export; the provider additionally keeps each
+// encoded record within MaxRecordBytes, which also bounds the request size.
+func (p *LoggerProvider) SetLimits(limits *fields.Limits) {
+	p.limits = limits
+}
+
+// SetFieldMapper makes the level, timestamp and message attributes of each
+// record follow the configured naming scheme. Names the mapper leaves at
+// their defaults keep the VictoriaLogs names level, @timestamp and _msg.
+func (p *LoggerProvider) SetFieldMapper(mapper *fields.FieldMapper) {
+	p.levelKey = recordKey(mapper, fields.LevelField, "level")
+	p.timeKey = recordKey(mapper, fields.TimestampField, "@timestamp")
+	p.messageKey = recordKey(mapper, fields.MessageField, "_msg")
+}
+
+func recordKey(mapper *fields.FieldMapper, field, defaultKey string) string {
+	if name := mapper.StandardName(field); name != field {
+		return name
+	}
+	return defaultKey
+}
+
 // NewOTLPClient creates a new OTLP client.
 func NewOTLPClient(opt *option.OTLPOption) (*OTLPClient, error) {
 	client := &OTLPClient{
@@ -166,7 +229,7 @@ func (p *LoggerProvider) createLogRecord(level core.Level, message string, attri
 	
 	// Add essential VictoriaLogs fields
 	otlpAttributes = append(otlpAttributes, &commonv1.KeyValue{
-		Key: "level",  // VictoriaLogs standard field
+		Key: orDefault(p.levelKey, "level"), // VictoriaLogs standard field
 		Value: &commonv1.AnyValue{
 			Value: &commonv1.AnyValue_StringValue{StringValue: strings.ToLower(level.String())},
 		},
@@ -174,7 +237,7 @@ func (p *LoggerProvider) createLogRecord(level core.Level, message string, attri
 
 	// Add timestamp as string for VictoriaLogs compatibility
 	otlpAttributes = append(otlpAttributes, &commonv1.KeyValue{
-		Key: "@timestamp",
+		Key: orDefault(p.timeKey, "@timestamp"),
 		Value: &commonv1.AnyValue{
 			Value: &commonv1.AnyValue_StringValue{StringValue: now.UTC().Format(time.RFC3339Nano)},
 		},
@@ -182,7 +245,7 @@ func (p *LoggerProvider) createLogRecord(level core.Level, message string, attri
 
 	// Ensure message is also in attributes as _msg (VictoriaLogs standard)
 	otlpAttributes = append(otlpAttributes, &commonv1.KeyValue{
-		Key: "_msg",
+		Key: orDefault(p.messageKey, "_msg"),
 		Value: &commonv1.AnyValue{
 			Value: &commonv1.AnyValue_StringValue{StringValue: message},
 		},
@@ -190,58 +253,11 @@ func (p *LoggerProvider) createLogRecord(level core.Level, message string, attri
 
 	// Convert user attributes with proper type handling
 	for key, value := range attributes {
-		otlpAttr := &commonv1.KeyValue{Key: key}
-		
-		switch v := value.(type) {
-		case string:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_StringValue{StringValue: v},
-			}
-		case int:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_IntValue{IntValue: int64(v)},
-			}
-		case int32:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_IntValue{IntValue: int64(v)},
-			}
-		case int64:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_IntValue{IntValue: v},
-			}
-		case float32:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_DoubleValue{DoubleValue: float64(v)},
-			}
-		case float64:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_DoubleValue{DoubleValue: v},
-			}
-		case bool:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_BoolValue{BoolValue: v},
-			}
-		case time.Time:
-			otlpAttr.Value = &commonv1.AnyValue{
-				Value: &commonv1.AnyValue_StringValue{StringValue: v.UTC().Format(time.RFC3339Nano)},
-			}
-		default:
-			// Convert complex types to JSON string
-			if jsonBytes, err := json.Marshal(v); err == nil {
-				otlpAttr.Value = &commonv1.AnyValue{
-					Value: &commonv1.AnyValue_StringValue{StringValue: string(jsonBytes)},
-				}
-			} else {
-				otlpAttr.Value = &commonv1.AnyValue{
-					Value: &commonv1.AnyValue_StringValue{StringValue: fmt.Sprintf("%v", v)},
-				}
-			}
-		}
-		
+		otlpAttr := &commonv1.KeyValue{Key: key, Value: toAnyValue(value)}
 		otlpAttributes = append(otlpAttributes, otlpAttr)
 	}
 
-	return &logsv1.LogRecord{
+	record := &logsv1.LogRecord{
 		TimeUnixNano:         uint64(now.UnixNano()),
 		ObservedTimeUnixNano: uint64(now.UnixNano()),
 		SeverityNumber:       mapLevelToSeverityNumber(level),
@@ -251,6 +267,178 @@ func (p *LoggerProvider) createLogRecord(level core.Level, message string, attri
 		},
 		Attributes: otlpAttributes,
 	}
+	p.limitRecord(record, message)
+	return record
+}
+
+func orDefault(key, defaultKey string) string {
+	if key == "" {
+		return defaultKey
+	}
+	return key
+}
+
+// limitRecord keeps the encoded record within MaxRecordBytes by dropping the
+// largest user attributes and then cutting the message, which appears both
+// as the body and as _msg. What was cut is added to the TruncatedField
+// attribute the engines use, which is not counted against the limit.
+func (p *LoggerProvider) limitRecord(record *logsv1.LogRecord, message string) {
+	if p.limits == nil || p.limits.MaxRecordBytes <= 0 {
+		return
+	}
+	limit := p.limits.MaxRecordBytes
+	size := proto.Size(record)
+	if size <= limit {
+		return
+	}
+
+	// Attributes are encoded as length-delimited entries with a one-byte tag
+	attrSize := func(kv *commonv1.KeyValue) int {
+		return 1 + protowire.SizeBytes(proto.Size(kv))
+	}
+	user := append([]*commonv1.KeyValue{}, record.Attributes[recordAttributes:]...)
+	sort.SliceStable(user, func(i, j int) bool {
+		if si, sj := attrSize(user[i]), attrSize(user[j]); si != sj {
+			return si > sj
+		}
+		return user[i].Key < user[j].Key
+	})
+	drop := make(map[*commonv1.KeyValue]bool)
+	var dropped []*commonv1.AnyValue
+	for _, kv := range user {
+		if size <= limit {
+			break
+		}
+		if kv.Key == fields.TruncatedField {
+			continue
+		}
+		drop[kv] = true
+		dropped = append(dropped, &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: kv.Key}})
+		size -= attrSize(kv)
+	}
+
+	kept := record.Attributes[:0]
+	var marker *commonv1.KeyValue
+	for _, kv := range record.Attributes {
+		if drop[kv] {
+			continue
+		}
+		if kv.Key == fields.TruncatedField {
+			marker = kv
+		}
+		kept = append(kept, kv)
+	}
+	record.Attributes = kept
+
+	if marker == nil {
+		marker = &commonv1.KeyValue{
+			Key:   fields.TruncatedField,
+			Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_KvlistValue{KvlistValue: &commonv1.KeyValueList{}}},
+		}
+		record.Attributes = append(record.Attributes, marker)
+	}
+	cuts := marker.Value.GetKvlistValue()
+	if cuts == nil {
+		return
+	}
+
+	if len(dropped) > 0 {
+		if prev := kvlistValue(cuts, "record").GetArrayValue(); prev != nil {
+			prev.Values = append(prev.Values, dropped...)
+		} else {
+			cuts.Values = append(cuts.Values, &commonv1.KeyValue{
+				Key:   "record",
+				Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_ArrayValue{ArrayValue: &commonv1.ArrayValue{Values: dropped}}},
+			})
+		}
+	}
+	if size > limit {
+		// The message is encoded twice, so each byte cut saves about two;
+		// a few more cover the shorter length prefixes
+		body := &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{
+			StringValue: truncateString(message, len(message)-(size-limit+1)/2-4),
+		}}
+		record.Body = body
+		record.Attributes[recordAttributes-1].Value = body
+		if kvlistValue(cuts, "message") == nil {
+			cuts.Values = append(cuts.Values, &commonv1.KeyValue{
+				Key:   "message",
+				Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: int64(len(message))}},
+			})
+		}
+	}
+}
+
+// kvlistValue returns the value stored under key in kvlist, or nil.
+func kvlistValue(kvlist *commonv1.KeyValueList, key string) *commonv1.AnyValue {
+	for _, kv := range kvlist.Values {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// truncateString cuts s to at most n bytes without splitting a UTF-8 sequence.
+func truncateString(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// toAnyValue converts an attribute value to an OTLP value. Nested objects
+// become KvlistValue and slices become ArrayValue; other complex types are
+// encoded as JSON strings.
+func toAnyValue(value interface{}) *commonv1.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v}}
+	case int:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: int64(v)}}
+	case int32:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: v}}
+	case float32:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_DoubleValue{DoubleValue: v}}
+	case bool:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_BoolValue{BoolValue: v}}
+	case time.Time:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v.UTC().Format(time.RFC3339Nano)}}
+	case map[string]interface{}:
+		// Sort keys so the same object always exports identically
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		kvs := make([]*commonv1.KeyValue, 0, len(v))
+		for _, key := range keys {
+			kvs = append(kvs, &commonv1.KeyValue{Key: key, Value: toAnyValue(v[key])})
+		}
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_KvlistValue{KvlistValue: &commonv1.KeyValueList{Values: kvs}}}
+	case []interface{}:
+		values := make([]*commonv1.AnyValue, 0, len(v))
+		for _, elem := range v {
+			values = append(values, toAnyValue(elem))
+		}
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_ArrayValue{ArrayValue: &commonv1.ArrayValue{Values: values}}}
+	default:
+		// Convert complex types to JSON string
+		if jsonBytes, err := json.Marshal(v); err == nil {
+			return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: string(jsonBytes)}}
+		}
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: fmt.Sprintf("%v", v)}}
+	}
 }
 
 // Export exports logs via gRPC or HTTP.
@@ -0,0 +1,196 @@
+package otlp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/fields"
+)
+
+func TestToAnyValue(t *testing.T) {
+	got := toAnyValue(map[string]interface{}{
+		"name": "alice",
+		"age":  int64(30),
+		"tags": []interface{}{"a", true},
+		"address": map[string]interface{}{
+			"city": "Berlin",
+		},
+	})
+
+	str := func(s string) *commonv1.AnyValue {
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: s}}
+	}
+	want := &commonv1.AnyValue{Value: &commonv1.AnyValue_KvlistValue{KvlistValue: &commonv1.KeyValueList{
+		Values: []*commonv1.KeyValue{
+			{Key: "address", Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_KvlistValue{KvlistValue: &commonv1.KeyValueList{
+				Values: []*commonv1.KeyValue{{Key: "city", Value: str("Berlin")}},
+			}}}},
+			{Key: "age", Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: 30}}},
+			{Key: "name", Value: str("alice")},
+			{Key: "tags", Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_ArrayValue{ArrayValue: &commonv1.ArrayValue{
+				Values: []*commonv1.AnyValue{str("a"), {Value: &commonv1.AnyValue_BoolValue{BoolValue: true}}},
+			}}}},
+		},
+	}}}
+
+	if !proto.Equal(got, want) {
+		t.Errorf("toAnyValue() = %v, want %v", got, want)
+	}
+}
+
+func TestToAnyValue_JSONFallback(t *testing.T) {
+	got := toAnyValue(struct {
+		ID int `json:"id"`
+	}{ID: 7})
+	if got.GetStringValue() != `{"id":7}` {
+		t.Errorf("toAnyValue() = %v, want JSON string", got)
+	}
+}
+
+func TestLoggerProvider_GroupAttributes(t *testing.T) {
+	attributes := map[string]interface{}{"method": "GET", "status": 200}
+
+	tests := []struct {
+		name    string
+		flatten bool
+		groups  []string
+		want    map[string]interface{}
+	}{
+		{"no groups", false, nil, attributes},
+		{"nested", false, []string{"http", "request"}, map[string]interface{}{
+			"http": map[string]interface{}{
+				"request": map[string]interface{}{"method": "GET", "status": 200},
+			},
+		}},
+		{"flattened", true, []string{"http", "request"}, map[string]interface{}{
+			"http.request.method": "GET",
+			"http.request.status": 200,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &LoggerProvider{flattenGroups: tt.flatten}
+			if got := p.GroupAttributes(tt.groups, attributes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupAttributes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggerProvider_FieldMapperRecordKeys(t *testing.T) {
+	tests := []struct {
+		scheme  fields.NamingScheme
+		mapping map[string]string
+		want    []string
+	}{
+		{fields.DefaultScheme, nil, []string{"level", "@timestamp", "_msg"}},
+		{fields.ECSScheme, nil, []string{"log.level", "@timestamp", "_msg"}},
+		{fields.OTelScheme, nil, []string{"severity_text", "@timestamp", "body"}},
+		{fields.GCPScheme, nil, []string{"severity", "time", "_msg"}},
+		{fields.DefaultScheme, map[string]string{"message": "msg"}, []string{"level", "@timestamp", "msg"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.scheme), func(t *testing.T) {
+			mapper, err := fields.NewFieldMapperWithScheme(tt.scheme, tt.mapping)
+			if err != nil {
+				t.Fatalf("Failed to create mapper: %v", err)
+			}
+			p := &LoggerProvider{}
+			p.SetFieldMapper(mapper)
+
+			record := p.createLogRecord(core.InfoLevel, "hello", nil)
+			var keys []string
+			for _, attr := range record.Attributes {
+				keys = append(keys, attr.Key)
+			}
+			if !reflect.DeepEqual(keys, tt.want) {
+				t.Errorf("Record keys = %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggerProvider_LimitRecord(t *testing.T) {
+	p := &LoggerProvider{limits: &fields.Limits{MaxRecordBytes: 300}}
+
+	// sizeWithoutMarker returns the encoded size of record without the
+	// TruncatedField attribute, which is not counted against the limit
+	sizeWithoutMarker := func(record *logsv1.LogRecord) int {
+		clone := proto.Clone(record).(*logsv1.LogRecord)
+		attrs := clone.Attributes[:0]
+		for _, kv := range clone.Attributes {
+			if kv.Key != fields.TruncatedField {
+				attrs = append(attrs, kv)
+			}
+		}
+		clone.Attributes = attrs
+		return proto.Size(clone)
+	}
+	attribute := func(record *logsv1.LogRecord, key string) *commonv1.AnyValue {
+		for _, kv := range record.Attributes {
+			if kv.Key == key {
+				return kv.Value
+			}
+		}
+		return nil
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+		record := p.createLogRecord(core.InfoLevel, "hello", map[string]interface{}{"user": "alice"})
+		if attribute(record, fields.TruncatedField) != nil {
+			t.Errorf("Unexpected %s attribute in %v", fields.TruncatedField, record)
+		}
+	})
+
+	t.Run("largest attributes are dropped", func(t *testing.T) {
+		record := p.createLogRecord(core.InfoLevel, "hello", map[string]interface{}{
+			"user": "alice",
+			"body": strings.Repeat("x", 1000),
+		})
+		if size := sizeWithoutMarker(record); size > 300 {
+			t.Errorf("Record size = %d, want at most 300", size)
+		}
+		if attribute(record, "body") != nil || attribute(record, "user") == nil {
+			t.Errorf("Expected only body to be dropped: %v", record.Attributes)
+		}
+		want := toAnyValue(map[string]interface{}{"record": []interface{}{"body"}})
+		if got := attribute(record, fields.TruncatedField); !proto.Equal(got, want) {
+			t.Errorf("%s = %v, want %v", fields.TruncatedField, got, want)
+		}
+	})
+
+	t.Run("message is cut", func(t *testing.T) {
+		message := strings.Repeat("m", 1000)
+		record := p.createLogRecord(core.InfoLevel, message, nil)
+		if size := sizeWithoutMarker(record); size > 300 {
+			t.Errorf("Record size = %d, want at most 300", size)
+		}
+		body := record.Body.GetStringValue()
+		if len(body) == 0 || !strings.HasPrefix(message, body) || attribute(record, "_msg").GetStringValue() != body {
+			t.Errorf("Unexpected body %q and _msg %v", body, attribute(record, "_msg"))
+		}
+		want := toAnyValue(map[string]interface{}{"message": 1000})
+		if got := attribute(record, fields.TruncatedField); !proto.Equal(got, want) {
+			t.Errorf("%s = %v, want %v", fields.TruncatedField, got, want)
+		}
+	})
+
+	t.Run("existing marker is extended", func(t *testing.T) {
+		record := p.createLogRecord(core.InfoLevel, "hello", map[string]interface{}{
+			"body":                strings.Repeat("x", 1000),
+			fields.TruncatedField: map[string]interface{}{"fields": 2},
+		})
+		want := toAnyValue(map[string]interface{}{"fields": 2, "record": []interface{}{"body"}})
+		if got := attribute(record, fields.TruncatedField); !proto.Equal(got, want) {
+			t.Errorf("%s = %v, want %v", fields.TruncatedField, got, want)
+		}
+	})
+}
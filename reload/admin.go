@@ -0,0 +1,300 @@
+package reload
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	"github.com/kart-io/logger/core"
+)
+
+// maxBodyBytes bounds the size of request bodies accepted by the admin handler
+const maxBodyBytes = 1 << 20
+
+// AdminOptions configures the admin HTTP handler
+type AdminOptions struct {
+	// Authorize is called before every request. A non-nil error rejects the
+	// request with 401 Unauthorized.
+	Authorize func(*http.Request) error
+
+	// Loggers are named loggers whose levels can be changed at runtime
+	Loggers map[string]core.Logger
+}
+
+// AdminHandler exposes runtime log control over HTTP:
+//
+//	GET  /config            current effective configuration
+//	PUT  /config            merge a partial configuration and reload
+//	GET  /status            reloader status including temporary level overrides
+//	GET  /loggers           registered loggers and their levels
+//	PUT  /loggers/{name}    change the level of a registered logger, optionally for a duration
+//	DELETE /loggers/{name}/override  cancel temporary level overrides
+//	POST /reload            re-read the config file or environment
+//	POST /rollback          roll back to the previous, an indexed or a timestamped backup
+//	GET  /history           backup configuration history
+//	GET  /decisions         reload decision log
+//	GET  /audit             reload failures and automatic rollbacks
+type AdminHandler struct {
+	reloader  *ConfigReloader
+	authorize func(*http.Request) error
+	mux       *http.ServeMux
+}
+
+// LoggerLevel is the JSON representation of a registered logger's level.
+// A non-empty Duration (e.g. "10m") makes the change temporary.
+type LoggerLevel struct {
+	Name     string `json:"name"`
+	Level    string `json:"level"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// RollbackRequest is the body accepted by POST /rollback. An empty request
+// rolls back to the previous configuration.
+type RollbackRequest struct {
+	Type      string     `json:"type,omitempty"`
+	Index     *int       `json:"index,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// NewAdminHandler creates an admin handler backed by the given reloader.
+// Mount it under a prefix with http.StripPrefix.
+func NewAdminHandler(reloader *ConfigReloader, opts *AdminOptions) *AdminHandler {
+	if opts == nil {
+		opts = &AdminOptions{}
+	}
+
+	h := &AdminHandler{
+		reloader:  reloader,
+		authorize: opts.Authorize,
+	}
+
+	for name, logger := range opts.Loggers {
+		reloader.RegisterLogger(name, logger)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /config", h.getConfig)
+	mux.HandleFunc("PUT /config", h.putConfig)
+	mux.HandleFunc("GET /status", h.getStatus)
+	mux.HandleFunc("GET /loggers", h.getLoggers)
+	mux.HandleFunc("PUT /loggers/{name}", h.putLoggerLevel)
+	mux.HandleFunc("DELETE /loggers/{name}/override", h.deleteLoggerOverride)
+	mux.HandleFunc("POST /reload", h.postReload)
+	mux.HandleFunc("POST /rollback", h.postRollback)
+	mux.HandleFunc("GET /history", h.getHistory)
+	mux.HandleFunc("GET /decisions", h.getDecisions)
+	mux.HandleFunc("GET /audit", h.getAudit)
+	h.mux = mux
+
+	return h
+}
+
+// RegisterLogger makes a logger's level controllable through the handler
+func (h *AdminHandler) RegisterLogger(name string, logger core.Logger) {
+	h.reloader.RegisterLogger(name, logger)
+}
+
+// ServeHTTP implements http.Handler
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.authorize != nil {
+		if err := h.authorize(r); err != nil {
+			writeError(w, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *AdminHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.reloader.GetCurrentConfig())
+}
+
+func (h *AdminHandler) putConfig(w http.ResponseWriter, r *http.Request) {
+	newConfig := h.reloader.GetCurrentConfig()
+	if newConfig == nil {
+		newConfig = config.DefaultConfig()
+	}
+
+	// Decode over a copy of the current config so partial updates are merged
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(newConfig); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid config: %w", err))
+		return
+	}
+
+	if err := newConfig.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.reloader.TriggerReloadFrom(SourceAPI, newConfig); err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, newConfig)
+}
+
+func (h *AdminHandler) getStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.reloader.GetStatus())
+}
+
+func (h *AdminHandler) getLoggers(w http.ResponseWriter, r *http.Request) {
+	loggers := h.reloader.GetStatus().Loggers
+	levels := make([]LoggerLevel, 0, len(loggers))
+	for _, status := range loggers {
+		levels = append(levels, LoggerLevel{Name: status.Name, Level: status.Level})
+	}
+	writeJSON(w, http.StatusOK, levels)
+}
+
+func (h *AdminHandler) putLoggerLevel(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	var req LoggerLevel
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+
+	level, err := core.ParseLevel(req.Level)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Duration != "" {
+		duration, parseErr := time.ParseDuration(req.Duration)
+		if parseErr != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid duration: %w", parseErr))
+			return
+		}
+		err = h.reloader.SetTemporaryLevel(name, level, duration)
+	} else {
+		err = h.reloader.SetLoggerLevel(name, level)
+		if err == nil {
+			h.reloader.log("info", fmt.Sprintf("Logger %s level changed to %s via admin API", name, level))
+		}
+	}
+
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrLoggerNotRegistered) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, LoggerLevel{Name: name, Level: level.String(), Duration: req.Duration})
+}
+
+func (h *AdminHandler) deleteLoggerOverride(w http.ResponseWriter, r *http.Request) {
+	if err := h.reloader.ClearTemporaryLevels(r.PathValue("name")); err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) postReload(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Source string `json:"source"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+			return
+		}
+	}
+
+	source := req.Source
+	if source == "" {
+		source = SourceEnv.String()
+		if h.reloader.config.ConfigFile != "" {
+			source = SourceFile.String()
+		}
+	}
+
+	var err error
+	switch source {
+	case SourceFile.String():
+		if h.reloader.config.ConfigFile == "" {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("no config file configured"))
+			return
+		}
+		var fileConfig *config.Config
+		fileConfig, err = h.reloader.loadConfigFromFile(h.reloader.config.ConfigFile)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		err = h.reloader.TriggerReloadFrom(SourceFile, fileConfig)
+	case SourceEnv.String():
+		err = h.reloader.TriggerEnvReload()
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unsupported reload source: %s", source))
+		return
+	}
+
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "reload triggered", "source": source})
+}
+
+func (h *AdminHandler) postRollback(w http.ResponseWriter, r *http.Request) {
+	var req RollbackRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+			return
+		}
+	}
+
+	var err error
+	switch {
+	case req.Index != nil:
+		err = h.reloader.RollbackToBackup(*req.Index)
+	case req.Timestamp != nil:
+		err = h.reloader.RollbackToTimestamp(*req.Timestamp)
+	case req.Type == "" || req.Type == "last_stable" || req.Type == "previous":
+		err = h.reloader.RollbackToPrevious()
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unsupported rollback type: %s", req.Type))
+		return
+	}
+
+	if err != nil {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.reloader.GetCurrentConfig())
+}
+
+func (h *AdminHandler) getHistory(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.reloader.GetBackupHistory())
+}
+
+func (h *AdminHandler) getDecisions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.reloader.GetDecisionLog())
+}
+
+func (h *AdminHandler) getAudit(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.reloader.GetAuditLog())
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
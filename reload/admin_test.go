@@ -0,0 +1,208 @@
+package reload
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	"github.com/kart-io/logger/core"
+	lerrors "github.com/kart-io/logger/errors"
+)
+
+type levelRecorder struct {
+	core.Logger
+	level core.Level
+}
+
+func (l *levelRecorder) SetLevel(level core.Level) {
+	l.level = level
+}
+
+func doRequest(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminHandler_Config(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true, BackupOnReload: true, BackupRetention: 5})
+	if err := reloader.Start(); err != nil {
+		t.Fatalf("Failed to start reloader: %v", err)
+	}
+	defer reloader.Stop()
+
+	h := NewAdminHandler(reloader, nil)
+
+	rec := doRequest(t, h, http.MethodGet, "/config", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	var cfg config.Config
+	if err := json.Unmarshal(rec.Body.Bytes(), &cfg); err != nil {
+		t.Fatalf("Failed to decode config: %v", err)
+	}
+	if cfg.Level != "INFO" {
+		t.Errorf("Expected INFO level, got %s", cfg.Level)
+	}
+
+	rec = doRequest(t, h, http.MethodPut, "/config", `{"level": "invalid_level"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid level, got %d", rec.Code)
+	}
+
+	rec = doRequest(t, h, http.MethodPut, "/config", `{"level": "DEBUG"}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("Expected 202, got %d: %s", rec.Code, rec.Body.String())
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	current := reloader.GetCurrentConfig()
+	if current.Level != "DEBUG" || current.Engine != "slog" {
+		t.Errorf("Expected partial update to be merged, got %+v", current)
+	}
+
+	rec = doRequest(t, h, http.MethodGet, "/history", "")
+	var history []BackupRecord
+	if err := json.Unmarshal(rec.Body.Bytes(), &history); err != nil {
+		t.Fatalf("Failed to decode history: %v", err)
+	}
+	if len(history) != 1 || history[0].Config.Level != "INFO" {
+		t.Errorf("Unexpected history: %+v", history)
+	}
+
+	rec = doRequest(t, h, http.MethodPost, "/rollback", `{"index": 0}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 for rollback, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if level := reloader.GetCurrentConfig().Level; level != "INFO" {
+		t.Errorf("Expected rollback to INFO, got %s", level)
+	}
+
+	rec = doRequest(t, h, http.MethodPost, "/rollback", "")
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected 409 when no backups remain, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_RejectedConfigLeavesCurrentUnchanged(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true})
+	reloader.currentConfig.Levels = map[string]string{"x": "DEBUG"}
+	reloader.currentConfig.Limits = &config.LimitsConfig{MaxFields: 10}
+
+	h := NewAdminHandler(reloader, nil)
+
+	rec := doRequest(t, h, http.MethodPut, "/config", `{"levels": {"x": "BOGUS"}, "limits": {"max_fields": -5}}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	current := reloader.GetCurrentConfig()
+	if current.Levels["x"] != "DEBUG" {
+		t.Errorf("Expected levels to be unchanged, got %v", current.Levels)
+	}
+	if current.Limits.MaxFields != 10 {
+		t.Errorf("Expected limits to be unchanged, got %+v", current.Limits)
+	}
+
+	// Modifying a returned copy must not reach the reloader either
+	current.Levels["x"] = "ERROR"
+	current.Limits.MaxFields = 1
+	if again := reloader.GetCurrentConfig(); again.Levels["x"] != "DEBUG" || again.Limits.MaxFields != 10 {
+		t.Errorf("Expected GetCurrentConfig to return a deep copy, got %v %+v", again.Levels, again.Limits)
+	}
+
+	rec = doRequest(t, h, http.MethodPut, "/config", `{"level": "`+strings.Repeat("x", maxBodyBytes)+`"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an oversized body, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_LoggerLevels(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	payments := &levelRecorder{Logger: lerrors.NewNoOpLogger()}
+
+	h := NewAdminHandler(reloader, &AdminOptions{
+		Loggers: map[string]core.Logger{"payments": payments},
+	})
+
+	rec := doRequest(t, h, http.MethodPut, "/loggers/payments", `{"level": "debug"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if payments.level != core.DebugLevel {
+		t.Errorf("Expected logger level to be debug, got %s", payments.level)
+	}
+
+	rec = doRequest(t, h, http.MethodGet, "/loggers", "")
+	var levels []LoggerLevel
+	if err := json.Unmarshal(rec.Body.Bytes(), &levels); err != nil {
+		t.Fatalf("Failed to decode loggers: %v", err)
+	}
+	if len(levels) != 1 || levels[0].Level != "debug" {
+		t.Errorf("Unexpected loggers response: %+v", levels)
+	}
+
+	rec = doRequest(t, h, http.MethodPut, "/loggers/unknown", `{"level": "debug"}`)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown logger, got %d", rec.Code)
+	}
+	rec = doRequest(t, h, http.MethodPut, "/loggers/payments", `{"level": "debug", "duration": "-1m"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a negative duration, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_Authorize(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	h := NewAdminHandler(reloader, &AdminOptions{
+		Authorize: func(r *http.Request) error {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				return errors.New("unauthorized")
+			}
+			return nil
+		},
+	})
+
+	rec := doRequest(t, h, http.MethodGet, "/config", "")
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 without token, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/config", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected 200 with token, got %d", rec.Code)
+	}
+}
+
+func TestConfigReloader_RollbackToTimestamp(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{BackupOnReload: true, BackupRetention: 5})
+
+	reloader.mu.Lock()
+	reloader.backupCurrentConfig()
+	if err := reloader.applyConfig(&config.Config{Engine: "slog", Level: "DEBUG", Format: "json"}); err != nil {
+		reloader.mu.Unlock()
+		t.Fatalf("Failed to apply config: %v", err)
+	}
+	reloader.mu.Unlock()
+
+	if err := reloader.RollbackToTimestamp(time.Now().Add(-time.Hour)); err == nil {
+		t.Error("Expected error when no backup was effective at the timestamp")
+	}
+
+	if err := reloader.RollbackToTimestamp(time.Now()); err != nil {
+		t.Fatalf("Failed to rollback to timestamp: %v", err)
+	}
+	if level := reloader.GetCurrentConfig().Level; level != "INFO" {
+		t.Errorf("Expected INFO after rollback, got %s", level)
+	}
+}
@@ -0,0 +1,256 @@
+package reload
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kart-io/logger/config"
+)
+
+// ReloadSource identifies where a configuration change originated
+type ReloadSource int
+
+const (
+	SourceUnknown ReloadSource = iota
+	SourceFile
+	SourceAPI
+	SourceEnv
+)
+
+func (s ReloadSource) String() string {
+	switch s {
+	case SourceFile:
+		return "file"
+	case SourceAPI:
+		return "api"
+	case SourceEnv:
+		return "env"
+	default:
+		return "unknown"
+	}
+}
+
+// priority returns the tie-break priority of the source (env > api > file)
+func (s ReloadSource) priority() int {
+	switch s {
+	case SourceEnv:
+		return 3
+	case SourceAPI:
+		return 2
+	case SourceFile:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ReloadEvent is a single configuration change waiting to be applied
+type ReloadEvent struct {
+	Source    ReloadSource
+	Config    *config.Config
+	Timestamp time.Time
+}
+
+// Decision reasons recorded in the reload decision log
+const (
+	ReasonSingleEvent       = "single_event"
+	ReasonLatestEvent       = "event_driven_latest"
+	ReasonPriorityTieBreak  = "priority_tie_break"
+	ReasonFallbackToValid   = "fallback_to_valid_source"
+	ReasonAllSourcesInvalid = "all_sources_failed"
+)
+
+// DecisionEvent describes one event considered by a reload decision
+type DecisionEvent struct {
+	Source    string    `json:"source"`
+	Timestamp time.Time `json:"timestamp"`
+	Valid     bool      `json:"valid"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// ReloadDecision records how a batch of coalesced events was resolved
+type ReloadDecision struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Events    []DecisionEvent `json:"events"`
+	Winner    string          `json:"winner,omitempty"`
+	Reason    string          `json:"reason"`
+	Applied   bool            `json:"applied"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// resolveEvents orders the events by timestamp (newest first), breaks ties that
+// share the same millisecond by source priority, and picks the first event whose
+// configuration passes validation. Events that fail validation are audited as
+// rejected.
+func (r *ConfigReloader) resolveEvents(events []*ReloadEvent) (*ReloadEvent, *ReloadDecision) {
+	decision := &ReloadDecision{
+		Timestamp: time.Now(),
+		Events:    make([]DecisionEvent, 0, len(events)),
+	}
+
+	ordered := make([]*ReloadEvent, len(events))
+	copy(ordered, events)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ti := ordered[i].Timestamp.Truncate(time.Millisecond)
+		tj := ordered[j].Timestamp.Truncate(time.Millisecond)
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return ordered[i].Source.priority() > ordered[j].Source.priority()
+	})
+
+	var winner *ReloadEvent
+	winnerIndex := -1
+	for i, event := range ordered {
+		record := DecisionEvent{
+			Source:    event.Source.String(),
+			Timestamp: event.Timestamp,
+		}
+
+		var err error
+		if r.config.ValidateBeforeReload {
+			err = r.validateConfig(event.Config)
+		}
+		if err != nil {
+			record.Error = err.Error()
+			r.mu.Lock()
+			r.recordAudit(event.Source, err, ActionRejected)
+			r.mu.Unlock()
+		} else {
+			record.Valid = true
+			if winner == nil {
+				winner = event
+				winnerIndex = i
+			}
+		}
+		decision.Events = append(decision.Events, record)
+	}
+
+	switch {
+	case winner == nil:
+		decision.Reason = ReasonAllSourcesInvalid
+	case len(ordered) == 1:
+		decision.Reason = ReasonSingleEvent
+	case winnerIndex > 0:
+		decision.Reason = ReasonFallbackToValid
+	case sameMillisecond(ordered[0], ordered[1]):
+		decision.Reason = ReasonPriorityTieBreak
+	default:
+		decision.Reason = ReasonLatestEvent
+	}
+
+	if winner != nil {
+		decision.Winner = winner.Source.String()
+	}
+
+	return winner, decision
+}
+
+func sameMillisecond(a, b *ReloadEvent) bool {
+	return a.Timestamp.Truncate(time.Millisecond).Equal(b.Timestamp.Truncate(time.Millisecond))
+}
+
+// loadConfigFromEnv overlays LOG_* environment variables onto a copy of base.
+// The second return value reports whether any variable was present.
+func loadConfigFromEnv(base *config.Config) (*config.Config, bool) {
+	cfg := &config.Config{}
+	if base != nil {
+		cfg = base.Clone()
+	}
+	if cfg.OTLP == nil {
+		cfg.OTLP = &config.OTLPConfig{}
+	}
+
+	found := false
+	lookup := func(name string) (string, bool) {
+		value, ok := os.LookupEnv(name)
+		if ok {
+			found = true
+		}
+		return value, ok
+	}
+
+	if v, ok := lookup("LOG_ENGINE"); ok {
+		cfg.Engine = v
+	}
+	if v, ok := lookup("LOG_LEVEL"); ok {
+		cfg.Level = v
+	}
+	if v, ok := lookup("LOG_FORMAT"); ok {
+		cfg.Format = v
+	}
+	if v, ok := lookup("LOG_OUTPUT_PATHS"); ok {
+		cfg.OutputPaths = strings.Split(v, ",")
+	}
+	if v, ok := lookup("LOG_OTLP_ENDPOINT"); ok {
+		cfg.OTLPEndpoint = v
+		cfg.OTLP.Endpoint = v
+	}
+	if v, ok := lookup("LOG_DEVELOPMENT"); ok {
+		cfg.Development, _ = strconv.ParseBool(v)
+	}
+	if v, ok := lookup("LOG_DISABLE_CALLER"); ok {
+		cfg.DisableCaller, _ = strconv.ParseBool(v)
+	}
+	if v, ok := lookup("LOG_DISABLE_STACKTRACE"); ok {
+		cfg.DisableStacktrace, _ = strconv.ParseBool(v)
+	}
+	if v, ok := lookup("LOG_DUPLICATE_KEYS"); ok {
+		cfg.DuplicateKeys = v
+	}
+	if v, ok := lookup("LOG_FIELD_NAMING"); ok {
+		if cfg.FieldNaming == nil {
+			cfg.FieldNaming = &config.FieldNamingConfig{}
+		}
+		cfg.FieldNaming.Scheme = v
+	}
+	if v, ok := lookup("LOG_TIME_LAYOUT"); ok {
+		if cfg.Encoder == nil {
+			cfg.Encoder = &config.EncoderConfig{}
+		}
+		cfg.Encoder.TimeLayout = v
+	}
+	if v, ok := lookup("LOG_TIME_ZONE"); ok {
+		if cfg.Encoder == nil {
+			cfg.Encoder = &config.EncoderConfig{}
+		}
+		cfg.Encoder.TimeZone = v
+	}
+	for name, limit := range map[string]func(*config.LimitsConfig) *int{
+		"LOG_MAX_MESSAGE_LENGTH": func(l *config.LimitsConfig) *int { return &l.MaxMessageLength },
+		"LOG_MAX_VALUE_LENGTH":   func(l *config.LimitsConfig) *int { return &l.MaxValueLength },
+		"LOG_MAX_FIELDS":         func(l *config.LimitsConfig) *int { return &l.MaxFields },
+		"LOG_MAX_DEPTH":          func(l *config.LimitsConfig) *int { return &l.MaxDepth },
+		"LOG_MAX_RECORD_BYTES":   func(l *config.LimitsConfig) *int { return &l.MaxRecordBytes },
+	} {
+		if v, ok := lookup(name); ok {
+			if n, err := strconv.Atoi(v); err == nil {
+				if cfg.Limits == nil {
+					cfg.Limits = &config.LimitsConfig{}
+				}
+				*limit(cfg.Limits) = n
+			}
+		}
+	}
+	if v, ok := lookup("LOG_OTLP_ENABLED"); ok {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.OTLP.Enabled = &enabled
+		}
+	}
+	if v, ok := lookup("LOG_OTLP_PROTOCOL"); ok {
+		cfg.OTLP.Protocol = v
+	}
+	if v, ok := lookup("LOG_OTLP_TIMEOUT"); ok {
+		if timeout, err := time.ParseDuration(v); err == nil {
+			cfg.OTLP.Timeout = timeout
+		}
+	}
+	if v, ok := lookup("LOG_OTLP_FLATTEN_GROUPS"); ok {
+		cfg.OTLP.FlattenGroups, _ = strconv.ParseBool(v)
+	}
+
+	return cfg, found
+}
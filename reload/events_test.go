@@ -0,0 +1,228 @@
+package reload
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	"github.com/kart-io/logger/factory"
+	"github.com/kart-io/logger/option"
+)
+
+func newTestReloader(t *testing.T, reloadConfig *ReloadConfig) *ConfigReloader {
+	t.Helper()
+
+	cfg := &config.Config{
+		Engine: "slog",
+		Level:  "INFO",
+		Format: "json",
+	}
+
+	opt := &option.LogOption{
+		Engine: "slog",
+		Level:  "INFO",
+		Format: "json",
+	}
+
+	reloader, err := NewConfigReloader(reloadConfig, cfg, factory.NewLoggerFactory(opt))
+	if err != nil {
+		t.Fatalf("Failed to create reloader: %v", err)
+	}
+	return reloader
+}
+
+func TestReloadSource_String(t *testing.T) {
+	tests := []struct {
+		source   ReloadSource
+		expected string
+	}{
+		{SourceFile, "file"},
+		{SourceAPI, "api"},
+		{SourceEnv, "env"},
+		{SourceUnknown, "unknown"},
+	}
+
+	for _, test := range tests {
+		if got := test.source.String(); got != test.expected {
+			t.Errorf("Expected %s, got %s", test.expected, got)
+		}
+	}
+}
+
+func TestResolveEvents_LatestWins(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true})
+
+	base := time.Now()
+	events := []*ReloadEvent{
+		{Source: SourceEnv, Config: &config.Config{Level: "ERROR"}, Timestamp: base},
+		{Source: SourceFile, Config: &config.Config{Level: "DEBUG"}, Timestamp: base.Add(time.Second)},
+	}
+
+	winner, decision := reloader.resolveEvents(events)
+	if winner == nil || winner.Source != SourceFile {
+		t.Fatalf("Expected latest file event to win, got %+v", winner)
+	}
+	if decision.Reason != ReasonLatestEvent {
+		t.Errorf("Expected reason %s, got %s", ReasonLatestEvent, decision.Reason)
+	}
+}
+
+func TestResolveEvents_PriorityTieBreak(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true})
+
+	ts := time.Now().Truncate(time.Millisecond)
+	events := []*ReloadEvent{
+		{Source: SourceFile, Config: &config.Config{Level: "INFO"}, Timestamp: ts},
+		{Source: SourceEnv, Config: &config.Config{Level: "ERROR"}, Timestamp: ts.Add(100 * time.Microsecond)},
+		{Source: SourceAPI, Config: &config.Config{Level: "DEBUG"}, Timestamp: ts},
+	}
+
+	winner, decision := reloader.resolveEvents(events)
+	if winner == nil || winner.Source != SourceEnv {
+		t.Fatalf("Expected env to win the tie, got %+v", winner)
+	}
+	if decision.Reason != ReasonPriorityTieBreak {
+		t.Errorf("Expected reason %s, got %s", ReasonPriorityTieBreak, decision.Reason)
+	}
+}
+
+func TestResolveEvents_FallbackToValid(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true})
+
+	base := time.Now()
+	events := []*ReloadEvent{
+		{Source: SourceAPI, Config: &config.Config{Level: "DEBUG"}, Timestamp: base},
+		{Source: SourceFile, Config: &config.Config{Level: "invalid_level"}, Timestamp: base.Add(time.Second)},
+	}
+
+	winner, decision := reloader.resolveEvents(events)
+	if winner == nil || winner.Source != SourceAPI {
+		t.Fatalf("Expected fallback to api event, got %+v", winner)
+	}
+	if decision.Reason != ReasonFallbackToValid {
+		t.Errorf("Expected reason %s, got %s", ReasonFallbackToValid, decision.Reason)
+	}
+	if decision.Events[0].Valid || decision.Events[0].Error == "" {
+		t.Error("Expected invalid file event to be recorded with its error")
+	}
+}
+
+func TestConfigReloader_Debounce(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{
+		ValidateBeforeReload: true,
+		DebounceWindow:       150 * time.Millisecond,
+		DecisionLogRetention: 10,
+	})
+
+	if err := reloader.Start(); err != nil {
+		t.Fatalf("Failed to start reloader: %v", err)
+	}
+	defer reloader.Stop()
+
+	if err := reloader.TriggerReloadFrom(SourceAPI, &config.Config{Engine: "slog", Level: "DEBUG", Format: "json"}); err != nil {
+		t.Fatalf("Failed to trigger reload: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := reloader.TriggerReloadFrom(SourceFile, &config.Config{Engine: "slog", Level: "WARN", Format: "json"}); err != nil {
+		t.Fatalf("Failed to trigger reload: %v", err)
+	}
+
+	// Nothing should be applied inside the window
+	time.Sleep(50 * time.Millisecond)
+	if level := reloader.GetCurrentConfig().Level; level != "INFO" {
+		t.Errorf("Expected config to be unchanged inside debounce window, got level %s", level)
+	}
+
+	time.Sleep(300 * time.Millisecond)
+
+	if level := reloader.GetCurrentConfig().Level; level != "WARN" {
+		t.Errorf("Expected latest event to be applied, got level %s", level)
+	}
+
+	decisions := reloader.GetDecisionLog()
+	if len(decisions) != 1 {
+		t.Fatalf("Expected a single coalesced decision, got %d", len(decisions))
+	}
+	if len(decisions[0].Events) != 2 || !decisions[0].Applied || decisions[0].Winner != "file" {
+		t.Errorf("Unexpected decision: %+v", decisions[0])
+	}
+}
+
+func TestConfigReloader_ValidatesEachEventOnce(t *testing.T) {
+	var calls atomic.Int32
+	reloader := newTestReloader(t, &ReloadConfig{
+		ValidateBeforeReload: true,
+		DebounceWindow:       50 * time.Millisecond,
+		ValidationFunc: func(*config.Config) error {
+			calls.Add(1)
+			return nil
+		},
+	})
+
+	if err := reloader.Start(); err != nil {
+		t.Fatalf("Failed to start reloader: %v", err)
+	}
+	defer reloader.Stop()
+
+	for _, level := range []string{"DEBUG", "WARN"} {
+		if err := reloader.TriggerReload(&config.Config{Engine: "slog", Level: level, Format: "json"}); err != nil {
+			t.Fatalf("Failed to trigger reload: %v", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	time.Sleep(250 * time.Millisecond)
+
+	if level := reloader.GetCurrentConfig().Level; level != "WARN" {
+		t.Fatalf("Expected latest event to be applied, got level %s", level)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("Expected one validation per event, got %d", n)
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "ERROR")
+	t.Setenv("LOG_OTLP_ENABLED", "false")
+
+	base := &config.Config{Engine: "zap", Level: "INFO", Format: "json"}
+	cfg, found := loadConfigFromEnv(base)
+	if !found {
+		t.Fatal("Expected environment variables to be found")
+	}
+
+	if cfg.Level != "ERROR" || cfg.Engine != "zap" {
+		t.Errorf("Unexpected env config: %+v", cfg)
+	}
+	if cfg.OTLP == nil || cfg.OTLP.Enabled == nil || *cfg.OTLP.Enabled {
+		t.Error("Expected OTLP to be disabled by environment")
+	}
+	if base.Level != "INFO" {
+		t.Error("Base config should not be modified")
+	}
+}
+
+func TestLoadConfigFromEnv_Limits(t *testing.T) {
+	t.Setenv("LOG_MAX_RECORD_BYTES", "4194304")
+	t.Setenv("LOG_MAX_FIELDS", "not-a-number")
+
+	base := &config.Config{Limits: &config.LimitsConfig{MaxFields: 64}}
+	cfg, _ := loadConfigFromEnv(base)
+
+	if cfg.Limits.MaxRecordBytes != 4194304 || cfg.Limits.MaxFields != 64 {
+		t.Errorf("Unexpected env limits: %+v", cfg.Limits)
+	}
+	if base.Limits.MaxRecordBytes != 0 {
+		t.Error("Base limits should not be modified")
+	}
+}
+
+func TestLoadConfigFromEnv_DuplicateKeys(t *testing.T) {
+	t.Setenv("LOG_DUPLICATE_KEYS", "suffix")
+
+	cfg, found := loadConfigFromEnv(&config.Config{DuplicateKeys: "first"})
+	if !found || cfg.DuplicateKeys != "suffix" {
+		t.Errorf("DuplicateKeys = %q (found %v), want suffix", cfg.DuplicateKeys, found)
+	}
+}
@@ -0,0 +1,254 @@
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	lerrors "github.com/kart-io/logger/errors"
+)
+
+// FailureCategory classifies why a configuration reload failed
+type FailureCategory string
+
+const (
+	// FailureParse means the configuration could not be decoded
+	FailureParse FailureCategory = "config_parse_failed"
+	// FailureValidation means the configuration is logically invalid
+	FailureValidation FailureCategory = "config_validation_failed"
+	// FailureInitialization means the configuration could not be put into
+	// effect, e.g. an output file cannot be opened or written
+	FailureInitialization FailureCategory = "system_initialization_failed"
+	// FailureConnection means an external endpoint such as the OTLP collector
+	// is unreachable. This is treated as a transient runtime problem.
+	FailureConnection FailureCategory = "runtime_connection_failed"
+)
+
+// ShouldRollback reports whether failures of this category make the
+// configuration unusable. Connection failures keep the new configuration
+// running so that the exporter can recover once the endpoint is back.
+func (c FailureCategory) ShouldRollback() bool {
+	return c != FailureConnection
+}
+
+// Audit actions recorded for reload failures
+const (
+	ActionRejected = "rejected"
+	ActionRollback = "rollback"
+	ActionContinue = "continue"
+)
+
+// ReloadError is a reload failure together with its category
+type ReloadError struct {
+	Category FailureCategory
+	Err      error
+}
+
+func (e *ReloadError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ReloadError) Unwrap() error {
+	return e.Err
+}
+
+// HealthProbeFunc verifies that an applied configuration actually works
+type HealthProbeFunc func(*config.Config) error
+
+// AuditEvent records a failed reload and how the reloader reacted to it
+type AuditEvent struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Source    string          `json:"source"`
+	Category  FailureCategory `json:"category"`
+	Action    string          `json:"action"`
+	Error     string          `json:"error"`
+}
+
+// ClassifyError returns the failure category of a reload error. Errors that
+// are not already classified are categorized by their cause: permission
+// problems are initialization failures, network errors are connection
+// failures, and everything else is treated as a validation failure.
+func ClassifyError(err error) FailureCategory {
+	var reloadErr *ReloadError
+	if errors.As(err, &reloadErr) {
+		return reloadErr.Category
+	}
+
+	var loggerErr *lerrors.LoggerError
+	if errors.As(err, &loggerErr) {
+		switch loggerErr.Type {
+		case lerrors.ConfigError:
+			return FailureValidation
+		case lerrors.OTLPError:
+			return FailureConnection
+		default:
+			return FailureInitialization
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return FailureConnection
+	}
+
+	if errors.Is(err, fs.ErrPermission) || errors.Is(err, fs.ErrNotExist) {
+		return FailureInitialization
+	}
+
+	return FailureValidation
+}
+
+// ProbeOutputs checks that every file output of the configuration accepts
+// writes: it opens the output for appending, performs a zero-length write and
+// syncs it. An output that does not exist yet is created to check its
+// directory and removed again, so the probe leaves no files behind.
+func ProbeOutputs(cfg *config.Config) error {
+	for _, path := range cfg.OutputPaths {
+		switch strings.ToLower(path) {
+		case "stdout", "stderr", "":
+			continue
+		}
+
+		created := false
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+		if errors.Is(err, fs.ErrNotExist) {
+			file, err = os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+			created = err == nil
+		}
+		if err != nil {
+			return lerrors.NewError(lerrors.OutputError, "reloader", fmt.Sprintf("cannot open output %s", path), err)
+		}
+		err = probeWrite(file)
+		file.Close()
+		if created {
+			os.Remove(path)
+		}
+		if err != nil {
+			return lerrors.NewError(lerrors.OutputError, "reloader", fmt.Sprintf("cannot write output %s", path), err)
+		}
+	}
+	return nil
+}
+
+// probeWrite writes nothing to file and syncs it. Devices such as /dev/null
+// cannot be synced, which is not a write failure.
+func probeWrite(file *os.File) error {
+	if _, err := file.Write(nil); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, errors.ErrUnsupported) {
+		return err
+	}
+	return nil
+}
+
+// ProbeOTLP checks that the configured OTLP endpoint accepts TCP connections.
+// It is a no-op when OTLP is disabled.
+func ProbeOTLP(cfg *config.Config, timeout time.Duration) error {
+	if !cfg.IsOTLPEnabled() {
+		return nil
+	}
+
+	address, err := otlpAddress(cfg.OTLP.Endpoint, cfg.OTLP.Protocol)
+	if err != nil {
+		return lerrors.NewError(lerrors.ConfigError, "reloader", "invalid OTLP endpoint", err)
+	}
+
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return lerrors.NewError(lerrors.OTLPError, "reloader", fmt.Sprintf("OTLP endpoint %s unreachable", address), err)
+	}
+	return conn.Close()
+}
+
+// otlpAddress converts an OTLP endpoint into a host:port dial address
+func otlpAddress(endpoint, protocol string) (string, error) {
+	host := endpoint
+	scheme := ""
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return "", err
+		}
+		host = u.Host
+		scheme = u.Scheme
+	}
+	if host == "" {
+		return "", fmt.Errorf("missing host in endpoint %q", endpoint)
+	}
+
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host, nil
+	}
+
+	port := "4317"
+	switch {
+	case scheme == "https":
+		port = "443"
+	case scheme == "http":
+		port = "80"
+	case protocol == "http":
+		port = "4318"
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// probeConfig runs the configured health probe, or the built-in output and
+// optional OTLP probes when none is set.
+func (r *ConfigReloader) probeConfig(cfg *config.Config) error {
+	if r.config.HealthProbe != nil {
+		return r.config.HealthProbe(cfg)
+	}
+
+	if err := ProbeOutputs(cfg); err != nil {
+		return err
+	}
+
+	if r.config.ProbeOTLP {
+		timeout := r.config.ProbeTimeout
+		if timeout <= 0 {
+			timeout = 3 * time.Second
+		}
+		return ProbeOTLP(cfg, timeout)
+	}
+	return nil
+}
+
+// recordAudit appends an audit event. The caller must hold the write lock.
+func (r *ConfigReloader) recordAudit(source ReloadSource, err error, action string) {
+	category := ClassifyError(err)
+	r.log("warn", fmt.Sprintf("Reload from %s failed (%s), action: %s: %v", source, category, action, err))
+
+	retention := r.config.AuditLogRetention
+	if retention <= 0 {
+		return
+	}
+
+	r.audit = append(r.audit, &AuditEvent{
+		Timestamp: time.Now(),
+		Source:    source.String(),
+		Category:  category,
+		Action:    action,
+		Error:     err.Error(),
+	})
+	if len(r.audit) > retention {
+		r.audit = r.audit[len(r.audit)-retention:]
+	}
+}
+
+// GetAuditLog returns the recorded reload failures, oldest first
+func (r *ConfigReloader) GetAuditLog() []*AuditEvent {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	audit := make([]*AuditEvent, len(r.audit))
+	copy(audit, r.audit)
+	return audit
+}
@@ -0,0 +1,234 @@
+package reload
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	lerrors "github.com/kart-io/logger/errors"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected FailureCategory
+		rollback bool
+	}{
+		{"parse", &ReloadError{Category: FailureParse, Err: errors.New("bad yaml")}, FailureParse, true},
+		{"wrapped reload error", fmt.Errorf("reload: %w", &ReloadError{Category: FailureValidation, Err: errors.New("bad level")}), FailureValidation, true},
+		{"permission", &os.PathError{Op: "open", Path: "/var/log/app.log", Err: os.ErrPermission}, FailureInitialization, true},
+		{"output error", lerrors.NewError(lerrors.OutputError, "test", "cannot open", nil), FailureInitialization, true},
+		{"otlp error", lerrors.NewError(lerrors.OTLPError, "test", "unreachable", nil), FailureConnection, false},
+		{"network error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, FailureConnection, false},
+		{"unknown", errors.New("something else"), FailureValidation, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category := ClassifyError(tt.err)
+			if category != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, category)
+			}
+			if category.ShouldRollback() != tt.rollback {
+				t.Errorf("Expected ShouldRollback() = %v for %s", tt.rollback, category)
+			}
+		})
+	}
+}
+
+func TestOTLPAddress(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		protocol string
+		expected string
+	}{
+		{"localhost:4317", "grpc", "localhost:4317"},
+		{"collector", "grpc", "collector:4317"},
+		{"collector", "http", "collector:4318"},
+		{"http://collector:4318/v1/logs", "http", "collector:4318"},
+		{"https://otel.example.com/v1/logs", "http", "otel.example.com:443"},
+	}
+
+	for _, tt := range tests {
+		address, err := otlpAddress(tt.endpoint, tt.protocol)
+		if err != nil {
+			t.Errorf("otlpAddress(%q) returned error: %v", tt.endpoint, err)
+			continue
+		}
+		if address != tt.expected {
+			t.Errorf("otlpAddress(%q) = %q, want %q", tt.endpoint, address, tt.expected)
+		}
+	}
+}
+
+func TestProbeOutputs(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := ProbeOutputs(&config.Config{OutputPaths: []string{"stdout", filepath.Join(dir, "app.log")}}); err != nil {
+		t.Errorf("Expected writable output to pass, got %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("Expected the probe to leave no files behind, found %d entries", len(entries))
+	}
+
+	existing := filepath.Join(dir, "existing.log")
+	if err := os.WriteFile(existing, []byte("kept\n"), 0644); err != nil {
+		t.Fatalf("Failed to create output: %v", err)
+	}
+	if err := ProbeOutputs(&config.Config{OutputPaths: []string{existing}}); err != nil {
+		t.Errorf("Expected existing output to pass, got %v", err)
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "kept\n" {
+		t.Errorf("Expected existing output to be untouched, got %q", data)
+	}
+
+	if _, err := os.Stat(os.DevNull); err == nil {
+		if err := ProbeOutputs(&config.Config{OutputPaths: []string{os.DevNull}}); err != nil {
+			t.Errorf("Expected %s to pass, got %v", os.DevNull, err)
+		}
+	}
+
+	err := ProbeOutputs(&config.Config{OutputPaths: []string{filepath.Join(dir, "missing", "app.log")}})
+	if err == nil {
+		t.Fatal("Expected error for output in missing directory")
+	}
+	if category := ClassifyError(err); category != FailureInitialization {
+		t.Errorf("Expected initialization failure, got %s", category)
+	}
+}
+
+func TestConfigReloader_HealthCheckFailureRejectsConfig(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{
+		ValidateBeforeReload: true,
+		BackupOnReload:       true,
+		BackupRetention:      5,
+		HealthCheck:          true,
+		AutoRollback:         true,
+		AuditLogRetention:    10,
+	})
+
+	badConfig := &config.Config{
+		Engine:      "slog",
+		Level:       "DEBUG",
+		Format:      "json",
+		OutputPaths: []string{filepath.Join(t.TempDir(), "missing", "app.log")},
+	}
+	if err := reloader.applyReload(SourceFile, badConfig); err == nil {
+		t.Fatal("Expected reload to fail health check")
+	}
+
+	if level := reloader.GetCurrentConfig().Level; level != "INFO" {
+		t.Errorf("Expected config to stay at INFO, got %s", level)
+	}
+	if opt := reloader.factory.GetOption(); opt.Level != "INFO" {
+		t.Errorf("Expected factory option to stay at INFO, got %s", opt.Level)
+	}
+	if backups := len(reloader.GetBackupConfigs()); backups != 0 {
+		t.Errorf("Expected no backup for a rejected config, got %d backups", backups)
+	}
+
+	audit := reloader.GetAuditLog()
+	if len(audit) != 1 {
+		t.Fatalf("Expected one audit event, got %d", len(audit))
+	}
+	if audit[0].Action != ActionRejected || audit[0].Category != FailureInitialization || audit[0].Source != "file" {
+		t.Errorf("Unexpected audit event: %+v", audit[0])
+	}
+}
+
+func TestConfigReloader_HealthProbeRunsWithoutLock(t *testing.T) {
+	var reloader *ConfigReloader
+	reloader = newTestReloader(t, &ReloadConfig{
+		HealthCheck: true,
+		HealthProbe: func(cfg *config.Config) error {
+			// Reading the reloader from a probe must not deadlock
+			reloader.GetCurrentConfig()
+			reloader.GetAuditLog()
+			return nil
+		},
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- reloader.applyReload(SourceAPI, &config.Config{Engine: "slog", Level: "DEBUG", Format: "json"})
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Reload failed: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Reload deadlocked while the health probe read the reloader")
+	}
+	if level := reloader.GetCurrentConfig().Level; level != "DEBUG" {
+		t.Errorf("Expected DEBUG to be applied, got %s", level)
+	}
+}
+
+func TestConfigReloader_OTLPFailureKeepsConfig(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to reserve port: %v", err)
+	}
+	endpoint := listener.Addr().String()
+	listener.Close()
+
+	reloader := newTestReloader(t, &ReloadConfig{
+		ValidateBeforeReload: true,
+		HealthCheck:          true,
+		ProbeOTLP:            true,
+		AutoRollback:         true,
+		AuditLogRetention:    10,
+	})
+
+	newConfig := &config.Config{
+		Engine:       "slog",
+		Level:        "DEBUG",
+		Format:       "json",
+		OTLPEndpoint: endpoint,
+	}
+	// Validation normalizes the OTLP endpoint, so the event takes the full path
+	reloader.processEvents([]*ReloadEvent{{Source: SourceAPI, Config: newConfig, Timestamp: time.Now()}})
+
+	if level := reloader.GetCurrentConfig().Level; level != "DEBUG" {
+		t.Errorf("Expected new config to stay applied, got %s", level)
+	}
+
+	audit := reloader.GetAuditLog()
+	if len(audit) != 1 || audit[0].Action != ActionContinue || audit[0].Category != FailureConnection {
+		t.Errorf("Unexpected audit log: %+v", audit)
+	}
+}
+
+func TestConfigReloader_ValidationFailureAudited(t *testing.T) {
+	reloader := newTestReloader(t, &ReloadConfig{ValidateBeforeReload: true, AuditLogRetention: 10})
+
+	reloader.processEvents([]*ReloadEvent{{
+		Source:    SourceAPI,
+		Config:    &config.Config{Engine: "slog", Level: "LOUD", Format: "json"},
+		Timestamp: time.Now(),
+	}})
+	if level := reloader.GetCurrentConfig().Level; level != "INFO" {
+		t.Errorf("Expected the invalid config not to be applied, got %s", level)
+	}
+
+	h := NewAdminHandler(reloader, nil)
+	rec := doRequest(t, h, http.MethodGet, "/audit", "")
+	var audit []AuditEvent
+	if err := json.Unmarshal(rec.Body.Bytes(), &audit); err != nil {
+		t.Fatalf("Failed to decode audit log: %v", err)
+	}
+	if len(audit) != 1 || audit[0].Category != FailureValidation || audit[0].Action != ActionRejected {
+		t.Errorf("Unexpected audit log: %+v", audit)
+	}
+}
@@ -0,0 +1,276 @@
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kart-io/logger/core"
+	"github.com/kart-io/logger/option"
+)
+
+// ErrLoggerNotRegistered is returned when a level change names a logger that
+// was never registered with the reloader
+var ErrLoggerNotRegistered = errors.New("logger is not registered")
+
+// managedLogger is a registered logger whose level is controlled at runtime
+type managedLogger struct {
+	logger    core.Logger
+	baseLevel core.Level
+	overrides []*levelOverride
+
+	// pinned is set once SetLoggerLevel replaces the configured level
+	pinned bool
+}
+
+// levelOverride is a temporary level that reverts automatically when it expires
+type levelOverride struct {
+	id        uint64
+	level     core.Level
+	expiresAt time.Time
+	timer     *time.Timer
+}
+
+// effectiveLevel returns the newest active override, or the base level
+func (m *managedLogger) effectiveLevel() core.Level {
+	if n := len(m.overrides); n > 0 {
+		return m.overrides[n-1].level
+	}
+	return m.baseLevel
+}
+
+// OverrideStatus describes an active temporary level override
+type OverrideStatus struct {
+	Level     string        `json:"level"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	Remaining time.Duration `json:"remaining"`
+}
+
+// LoggerStatus describes the level state of a registered logger
+type LoggerStatus struct {
+	Name      string           `json:"name"`
+	Level     string           `json:"level"`
+	BaseLevel string           `json:"base_level"`
+	Overrides []OverrideStatus `json:"overrides,omitempty"`
+}
+
+// ReloaderStatus is a snapshot of the reloader's runtime state
+type ReloaderStatus struct {
+	Running     bool           `json:"running"`
+	ConfigLevel string         `json:"config_level,omitempty"`
+	Backups     int            `json:"backups"`
+	Loggers     []LoggerStatus `json:"loggers"`
+}
+
+// RegisterLogger makes a logger's level controllable through the reloader.
+// The base level is the level configured for the logger's name, or the
+// level of the current configuration for loggers without named levels.
+func (r *ConfigReloader) RegisterLogger(name string, logger core.Logger) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	level := core.InfoLevel
+	if resetter, ok := logger.(core.LevelResetter); ok {
+		level = resetter.ConfiguredLevel()
+	} else if r.currentConfig != nil {
+		if parsed, err := core.ParseLevel(r.currentConfig.Level); err == nil {
+			level = parsed
+		}
+	}
+
+	if existing, ok := r.loggers[name]; ok {
+		existing.stopOverrides()
+	}
+	r.loggers[name] = &managedLogger{logger: logger, baseLevel: level}
+}
+
+// SetLoggerLevel permanently changes the level of a registered logger.
+// Active temporary overrides keep precedence until they expire.
+func (r *ConfigReloader) SetLoggerLevel(name string, level core.Level) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	managed, ok := r.loggers[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrLoggerNotRegistered, name)
+	}
+
+	managed.baseLevel = level
+	managed.pinned = true
+	managed.logger.SetLevel(managed.effectiveLevel())
+	return nil
+}
+
+// SetTemporaryLevel sets the level of a registered logger for the given
+// duration, after which it reverts automatically. Overlapping overrides
+// stack: the newest active override wins, and when it expires the logger
+// falls back to the next active override or to its base level.
+func (r *ConfigReloader) SetTemporaryLevel(name string, level core.Level, duration time.Duration) error {
+	if duration <= 0 {
+		return fmt.Errorf("override duration must be positive, got %s", duration)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	managed, ok := r.loggers[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrLoggerNotRegistered, name)
+	}
+
+	r.overrideSeq++
+	override := &levelOverride{
+		id:        r.overrideSeq,
+		level:     level,
+		expiresAt: time.Now().Add(duration),
+	}
+	id := override.id
+	override.timer = time.AfterFunc(duration, func() {
+		r.expireOverride(name, id)
+	})
+
+	managed.overrides = append(managed.overrides, override)
+	managed.logger.SetLevel(level)
+
+	r.log("info", fmt.Sprintf("Logger %s level temporarily set to %s for %s", name, level, duration))
+	return nil
+}
+
+// ClearTemporaryLevels cancels all temporary overrides of a registered logger
+// and restores its base level.
+func (r *ConfigReloader) ClearTemporaryLevels(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	managed, ok := r.loggers[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrLoggerNotRegistered, name)
+	}
+
+	managed.stopOverrides()
+	managed.restoreLevel()
+
+	r.log("info", fmt.Sprintf("Logger %s temporary levels cleared, reverted to %s", name, managed.baseLevel))
+	return nil
+}
+
+// GetStatus returns a snapshot of the reloader state including the remaining
+// time of every temporary level override.
+func (r *ConfigReloader) GetStatus() ReloaderStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	status := ReloaderStatus{
+		Running: r.running,
+		Backups: len(r.backupConfigs),
+		Loggers: make([]LoggerStatus, 0, len(r.loggers)),
+	}
+	if r.currentConfig != nil {
+		status.ConfigLevel = r.currentConfig.Level
+	}
+
+	for name, managed := range r.loggers {
+		loggerStatus := LoggerStatus{
+			Name:      name,
+			Level:     managed.effectiveLevel().String(),
+			BaseLevel: managed.baseLevel.String(),
+		}
+		for _, override := range managed.overrides {
+			remaining := override.expiresAt.Sub(now)
+			if remaining < 0 {
+				remaining = 0
+			}
+			loggerStatus.Overrides = append(loggerStatus.Overrides, OverrideStatus{
+				Level:     override.level.String(),
+				ExpiresAt: override.expiresAt,
+				Remaining: remaining,
+			})
+		}
+		status.Loggers = append(status.Loggers, loggerStatus)
+	}
+
+	sort.Slice(status.Loggers, func(i, j int) bool {
+		return status.Loggers[i].Name < status.Loggers[j].Name
+	})
+	return status
+}
+
+// applyNamedLevels pushes the default and per-component levels of a newly
+// applied configuration to registered loggers that support them, without
+// rebuilding the loggers. Their base levels follow the new configuration,
+// replacing levels set with SetLoggerLevel. Active temporary overrides keep
+// precedence. The caller must hold the write lock.
+func (r *ConfigReloader) applyNamedLevels(opt *option.LogOption) {
+	if opt == nil {
+		return
+	}
+
+	defaultLevel, err := core.ParseLevel(opt.Level)
+	if err != nil {
+		return
+	}
+	levels, err := opt.ParseLevels()
+	if err != nil {
+		return
+	}
+
+	for _, managed := range r.loggers {
+		setter, ok := managed.logger.(core.NamedLevelSetter)
+		if !ok {
+			continue
+		}
+		setter.SetNamedLevels(defaultLevel, levels)
+		if resetter, ok := managed.logger.(core.LevelResetter); ok {
+			managed.baseLevel = resetter.ConfiguredLevel()
+			managed.pinned = false
+		}
+		if len(managed.overrides) > 0 {
+			managed.logger.SetLevel(managed.effectiveLevel())
+		}
+	}
+}
+
+// expireOverride removes an override once its timer fires and reverts the
+// logger to whatever level is now effective.
+func (r *ConfigReloader) expireOverride(name string, id uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	managed, ok := r.loggers[name]
+	if !ok {
+		return
+	}
+
+	for i, override := range managed.overrides {
+		if override.id == id {
+			managed.overrides = append(managed.overrides[:i], managed.overrides[i+1:]...)
+			managed.restoreLevel()
+			level := managed.effectiveLevel()
+			r.log("info", fmt.Sprintf("Temporary %s level override for logger %s expired, reverted to %s",
+				override.level, name, level))
+			return
+		}
+	}
+}
+
+// restoreLevel applies the effective level after an override is removed.
+// Once no overrides remain, a logger following its configured level drops
+// the level written into its level registry instead of pinning the base level.
+func (m *managedLogger) restoreLevel() {
+	if len(m.overrides) == 0 && !m.pinned {
+		if resetter, ok := m.logger.(core.LevelResetter); ok {
+			resetter.ResetLevel()
+			return
+		}
+	}
+	m.logger.SetLevel(m.effectiveLevel())
+}
+
+func (m *managedLogger) stopOverrides() {
+	for _, override := range m.overrides {
+		override.timer.Stop()
+	}
+	m.overrides = nil
+}
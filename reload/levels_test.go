@@ -0,0 +1,254 @@
+package reload
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kart-io/logger/config"
+	"github.com/kart-io/logger/core"
+	slogengine "github.com/kart-io/logger/engines/slog"
+	lerrors "github.com/kart-io/logger/errors"
+	"github.com/kart-io/logger/option"
+)
+
+type syncLevelRecorder struct {
+	core.Logger
+	mu    sync.Mutex
+	level core.Level
+}
+
+func (l *syncLevelRecorder) SetLevel(level core.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level = level
+}
+
+func (l *syncLevelRecorder) current() core.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.level
+}
+
+func TestConfigReloader_SetTemporaryLevel(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	logger := &syncLevelRecorder{Logger: lerrors.NewNoOpLogger()}
+	reloader.RegisterLogger("app", logger)
+
+	if err := reloader.SetTemporaryLevel("app", core.DebugLevel, 100*time.Millisecond); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	if logger.current() != core.DebugLevel {
+		t.Fatalf("Expected debug level during override, got %s", logger.current())
+	}
+
+	status := reloader.GetStatus()
+	if len(status.Loggers) != 1 || len(status.Loggers[0].Overrides) != 1 {
+		t.Fatalf("Expected one active override in status, got %+v", status.Loggers)
+	}
+	if remaining := status.Loggers[0].Overrides[0].Remaining; remaining <= 0 || remaining > 100*time.Millisecond {
+		t.Errorf("Unexpected remaining time %s", remaining)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	if logger.current() != core.InfoLevel {
+		t.Errorf("Expected revert to info level, got %s", logger.current())
+	}
+	if overrides := reloader.GetStatus().Loggers[0].Overrides; len(overrides) != 0 {
+		t.Errorf("Expected no overrides after expiry, got %d", len(overrides))
+	}
+}
+
+func TestConfigReloader_OverlappingOverrides(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	logger := &syncLevelRecorder{Logger: lerrors.NewNoOpLogger()}
+	reloader.RegisterLogger("app", logger)
+
+	if err := reloader.SetTemporaryLevel("app", core.DebugLevel, 300*time.Millisecond); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	if err := reloader.SetTemporaryLevel("app", core.WarnLevel, 100*time.Millisecond); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	if logger.current() != core.WarnLevel {
+		t.Fatalf("Expected newest override to win, got %s", logger.current())
+	}
+
+	// The shorter override expires first and the longer one takes over again
+	time.Sleep(180 * time.Millisecond)
+	if logger.current() != core.DebugLevel {
+		t.Errorf("Expected fallback to the remaining override, got %s", logger.current())
+	}
+
+	time.Sleep(250 * time.Millisecond)
+	if logger.current() != core.InfoLevel {
+		t.Errorf("Expected revert to base level, got %s", logger.current())
+	}
+}
+
+func TestConfigReloader_ClearTemporaryLevels(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	logger := &syncLevelRecorder{Logger: lerrors.NewNoOpLogger()}
+	reloader.RegisterLogger("app", logger)
+
+	if err := reloader.SetTemporaryLevel("app", core.DebugLevel, time.Hour); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	if err := reloader.ClearTemporaryLevels("app"); err != nil {
+		t.Fatalf("Failed to clear overrides: %v", err)
+	}
+	if logger.current() != core.InfoLevel {
+		t.Errorf("Expected base level after clearing, got %s", logger.current())
+	}
+
+	if err := reloader.SetTemporaryLevel("missing", core.DebugLevel, time.Minute); err == nil {
+		t.Error("Expected error for unregistered logger")
+	}
+	if err := reloader.SetTemporaryLevel("app", core.DebugLevel, 0); err == nil {
+		t.Error("Expected error for non-positive duration")
+	}
+}
+
+func TestAdminHandler_TemporaryLevel(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	logger := &syncLevelRecorder{Logger: lerrors.NewNoOpLogger()}
+	h := NewAdminHandler(reloader, &AdminOptions{Loggers: map[string]core.Logger{"app": logger}})
+
+	rec := doRequest(t, h, http.MethodPut, "/loggers/app", `{"level": "debug", "duration": "10m"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if logger.current() != core.DebugLevel {
+		t.Errorf("Expected debug level, got %s", logger.current())
+	}
+
+	rec = doRequest(t, h, http.MethodPut, "/loggers/app", `{"level": "debug", "duration": "soon"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid duration, got %d", rec.Code)
+	}
+
+	rec = doRequest(t, h, http.MethodDelete, "/loggers/app/override", "")
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", rec.Code)
+	}
+	if logger.current() != core.InfoLevel {
+		t.Errorf("Expected info level after clearing, got %s", logger.current())
+	}
+}
+
+type namedLevelRecorder struct {
+	syncLevelRecorder
+	defaultLevel core.Level
+	levels       map[string]core.Level
+}
+
+func (l *namedLevelRecorder) SetNamedLevels(defaultLevel core.Level, levels map[string]core.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.defaultLevel = defaultLevel
+	l.levels = levels
+}
+
+func TestConfigReloader_AppliesNamedLevels(t *testing.T) {
+	reloader := newTestReloader(t, nil)
+	logger := &namedLevelRecorder{syncLevelRecorder: syncLevelRecorder{Logger: lerrors.NewNoOpLogger()}}
+	reloader.RegisterLogger("app", logger)
+
+	if err := reloader.SetTemporaryLevel("app", core.ErrorLevel, time.Hour); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+
+	reloader.mu.Lock()
+	err := reloader.applyConfig(&config.Config{
+		Engine: "slog",
+		Level:  "WARN",
+		Format: "json",
+		Levels: map[string]string{"payments": "debug"},
+	})
+	reloader.mu.Unlock()
+	if err != nil {
+		t.Fatalf("Failed to apply config: %v", err)
+	}
+
+	logger.mu.Lock()
+	defaultLevel, levels := logger.defaultLevel, logger.levels
+	logger.mu.Unlock()
+
+	if defaultLevel != core.WarnLevel {
+		t.Errorf("Expected default level warn, got %s", defaultLevel)
+	}
+	if levels["payments"] != core.DebugLevel {
+		t.Errorf("Expected payments level debug, got %v", levels)
+	}
+	if logger.current() != core.ErrorLevel {
+		t.Errorf("Expected active override to be re-applied, got %s", logger.current())
+	}
+}
+
+func TestConfigReloader_OverrideExpiryRestoresConfiguredLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	root, err := slogengine.NewSlogLogger(&option.LogOption{
+		Engine:      "slog",
+		Level:       "INFO",
+		Format:      "json",
+		OutputPaths: []string{path},
+		Levels:      map[string]string{"payments": "DEBUG"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	payments := root.Named("payments")
+
+	reloader := newTestReloader(t, nil)
+	reloader.RegisterLogger("payments", payments)
+	if base := reloader.GetStatus().Loggers[0].BaseLevel; base != "debug" {
+		t.Errorf("Expected base level from the per-component config, got %s", base)
+	}
+
+	if err := reloader.SetTemporaryLevel("payments", core.ErrorLevel, 50*time.Millisecond); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	time.Sleep(150 * time.Millisecond)
+	payments.Debug("after expiry")
+
+	reloader.mu.Lock()
+	err = reloader.applyConfig(&config.Config{
+		Engine: "slog",
+		Level:  "INFO",
+		Format: "json",
+		Levels: map[string]string{"payments": "WARN"},
+	})
+	reloader.mu.Unlock()
+	if err != nil {
+		t.Fatalf("Failed to apply config: %v", err)
+	}
+	if base := reloader.GetStatus().Loggers[0].BaseLevel; base != "warn" {
+		t.Errorf("Expected base level to follow the reloaded config, got %s", base)
+	}
+
+	if err := reloader.SetTemporaryLevel("payments", core.DebugLevel, time.Hour); err != nil {
+		t.Fatalf("Failed to set temporary level: %v", err)
+	}
+	if err := reloader.ClearTemporaryLevels("payments"); err != nil {
+		t.Fatalf("Failed to clear temporary levels: %v", err)
+	}
+	payments.Info("after clear")
+	payments.Warn("warn after clear")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	output := string(data)
+	if !strings.Contains(output, "after expiry") {
+		t.Errorf("Expected debug entry after the override expired, got %s", output)
+	}
+	if strings.Contains(output, `"after clear"`) || !strings.Contains(output, "warn after clear") {
+		t.Errorf("Expected the reloaded warn level after clearing, got %s", output)
+	}
+}
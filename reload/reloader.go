@@ -82,6 +82,34 @@ type ReloadConfig struct {
 	// Callback function called after successful reload
 	Callback ReloadCallback
 
+	// DebounceWindow coalesces events arriving within the window into a single
+	// reload (default: 3s). Zero applies every event immediately.
+	DebounceWindow time.Duration
+
+	// DecisionLogRetention number of reload decisions to keep
+	DecisionLogRetention int
+
+	// HealthCheck probes a new configuration (output files and, if ProbeOTLP
+	// is set, the OTLP endpoint) before applying it
+	HealthCheck bool
+
+	// HealthProbe replaces the built-in health probe
+	HealthProbe HealthProbeFunc
+
+	// ProbeOTLP checks OTLP endpoint connectivity during the health check.
+	// Connection failures are audited but never reject the configuration.
+	ProbeOTLP bool
+
+	// ProbeTimeout bounds the OTLP connectivity check (default: 3s)
+	ProbeTimeout time.Duration
+
+	// AutoRollback reverts to the previous configuration when applying a
+	// new configuration fails
+	AutoRollback bool
+
+	// AuditLogRetention number of reload failure audit events to keep
+	AuditLogRetention int
+
 	// Logger for internal logging
 	Logger core.Logger
 }
@@ -95,6 +123,12 @@ func DefaultReloadConfig() *ReloadConfig {
 		ReloadTimeout:        30 * time.Second,
 		BackupOnReload:       true,
 		BackupRetention:      5,
+		DebounceWindow:       3 * time.Second,
+		DecisionLogRetention: 20,
+		HealthCheck:          true,
+		ProbeTimeout:         3 * time.Second,
+		AutoRollback:         true,
+		AuditLogRetention:    50,
 	}
 }
 
@@ -106,9 +140,15 @@ type ConfigReloader struct {
 	factory          *factory.LoggerFactory
 	watcher          *fsnotify.Watcher
 	signalChan       chan os.Signal
-	reloadChan       chan *config.Config
+	reloadChan       chan *ReloadEvent
 	errorHandler     *errors.ErrorHandler
 	backupConfigs    []*config.Config
+	backupAppliedAt  []time.Time
+	appliedAt        time.Time
+	decisions        []*ReloadDecision
+	audit            []*AuditEvent
+	loggers          map[string]*managedLogger
+	overrideSeq      uint64
 	ctx              context.Context
 	cancel           context.CancelFunc
 	running          bool
@@ -126,9 +166,11 @@ func NewConfigReloader(reloadConfig *ReloadConfig, initialConfig *config.Config,
 		config:        reloadConfig,
 		currentConfig: initialConfig,
 		factory:       factory,
-		reloadChan:    make(chan *config.Config, 10),
+		reloadChan:    make(chan *ReloadEvent, 10),
 		errorHandler:  errors.NewErrorHandler(nil),
 		backupConfigs: make([]*config.Config, 0, reloadConfig.BackupRetention),
+		appliedAt:     time.Now(),
+		loggers:       make(map[string]*managedLogger),
 		ctx:           ctx,
 		cancel:        cancel,
 	}
@@ -217,16 +259,26 @@ func (r *ConfigReloader) Stop() error {
 
 // TriggerReload manually triggers a configuration reload
 func (r *ConfigReloader) TriggerReload(newConfig *config.Config) error {
-	if !r.isRunning() {
-		return fmt.Errorf("reloader is not running")
-	}
+	return r.TriggerReloadFrom(SourceAPI, newConfig)
+}
 
-	select {
-	case r.reloadChan <- newConfig:
-		return nil
-	case <-time.After(5 * time.Second):
-		return fmt.Errorf("timeout triggering reload")
+// TriggerReloadFrom triggers a configuration reload attributed to the given source
+func (r *ConfigReloader) TriggerReloadFrom(source ReloadSource, newConfig *config.Config) error {
+	return r.enqueue(&ReloadEvent{
+		Source:    source,
+		Config:    newConfig,
+		Timestamp: time.Now(),
+	})
+}
+
+// TriggerEnvReload re-reads LOG_* environment variables on top of the current
+// configuration and triggers a reload attributed to the environment source
+func (r *ConfigReloader) TriggerEnvReload() error {
+	envConfig, found := loadConfigFromEnv(r.GetCurrentConfig())
+	if !found {
+		return fmt.Errorf("no logger environment variables set")
 	}
+	return r.TriggerReloadFrom(SourceEnv, envConfig)
 }
 
 // GetCurrentConfig returns the current configuration
@@ -238,9 +290,8 @@ func (r *ConfigReloader) GetCurrentConfig() *config.Config {
 		return nil
 	}
 	
-	// Return a copy to prevent external modifications
-	configCopy := *r.currentConfig
-	return &configCopy
+	// Return a deep copy to prevent external modifications
+	return r.currentConfig.Clone()
 }
 
 // GetBackupConfigs returns the backup configurations
@@ -248,15 +299,51 @@ func (r *ConfigReloader) GetBackupConfigs() []*config.Config {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	
-	// Return copies to prevent external modifications
+	// Return deep copies to prevent external modifications
 	backups := make([]*config.Config, len(r.backupConfigs))
 	for i, backup := range r.backupConfigs {
-		configCopy := *backup
-		backups[i] = &configCopy
+		backups[i] = backup.Clone()
 	}
 	return backups
 }
 
+// GetDecisionLog returns the most recent reload decisions, oldest first
+func (r *ConfigReloader) GetDecisionLog() []*ReloadDecision {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	decisions := make([]*ReloadDecision, len(r.decisions))
+	for i, decision := range r.decisions {
+		decisionCopy := *decision
+		decisionCopy.Events = append([]DecisionEvent(nil), decision.Events...)
+		decisions[i] = &decisionCopy
+	}
+	return decisions
+}
+
+// BackupRecord describes a backup configuration and when it became effective
+type BackupRecord struct {
+	Index     int            `json:"index"`
+	AppliedAt time.Time      `json:"applied_at"`
+	Config    *config.Config `json:"config"`
+}
+
+// GetBackupHistory returns the backup configurations with their timestamps, oldest first
+func (r *ConfigReloader) GetBackupHistory() []BackupRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := make([]BackupRecord, len(r.backupConfigs))
+	for i, backup := range r.backupConfigs {
+		history[i] = BackupRecord{
+			Index:     i,
+			AppliedAt: r.backupAppliedAt[i],
+			Config:    backup.Clone(),
+		}
+	}
+	return history
+}
+
 // RollbackToPrevious rolls back to the previous configuration
 func (r *ConfigReloader) RollbackToPrevious() error {
 	r.mu.Lock()
@@ -266,21 +353,55 @@ func (r *ConfigReloader) RollbackToPrevious() error {
 		return fmt.Errorf("no backup configuration available for rollback")
 	}
 
-	// Get the most recent backup
-	prevConfig := r.backupConfigs[len(r.backupConfigs)-1]
-	
-	// Apply the backup configuration
-	if err := r.applyConfig(prevConfig); err != nil {
+	if err := r.rollbackTo(len(r.backupConfigs) - 1); err != nil {
 		return fmt.Errorf("failed to rollback to previous configuration: %w", err)
 	}
 
-	// Remove the backup we just used
-	r.backupConfigs = r.backupConfigs[:len(r.backupConfigs)-1]
-
 	r.log("info", "Successfully rolled back to previous configuration")
 	return nil
 }
 
+// RollbackToBackup rolls back to the backup at the given index as reported by
+// GetBackupHistory. The selected backup and all newer backups are discarded.
+func (r *ConfigReloader) RollbackToBackup(index int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if index < 0 || index >= len(r.backupConfigs) {
+		return fmt.Errorf("backup index %d out of range (have %d backups)", index, len(r.backupConfigs))
+	}
+
+	if err := r.rollbackTo(index); err != nil {
+		return fmt.Errorf("failed to rollback to backup %d: %w", index, err)
+	}
+
+	r.log("info", fmt.Sprintf("Successfully rolled back to backup %d", index))
+	return nil
+}
+
+// RollbackToTimestamp rolls back to the configuration that was effective at the given time
+func (r *ConfigReloader) RollbackToTimestamp(ts time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	index := -1
+	for i, appliedAt := range r.backupAppliedAt {
+		if !appliedAt.After(ts) {
+			index = i
+		}
+	}
+	if index < 0 {
+		return fmt.Errorf("no backup configuration effective at %s", ts.Format(time.RFC3339))
+	}
+
+	if err := r.rollbackTo(index); err != nil {
+		return fmt.Errorf("failed to rollback to %s: %w", ts.Format(time.RFC3339), err)
+	}
+
+	r.log("info", fmt.Sprintf("Successfully rolled back to configuration effective at %s", ts.Format(time.RFC3339)))
+	return nil
+}
+
 // Private methods
 
 func (r *ConfigReloader) isRunning() bool {
@@ -289,6 +410,19 @@ func (r *ConfigReloader) isRunning() bool {
 	return r.running
 }
 
+func (r *ConfigReloader) enqueue(event *ReloadEvent) error {
+	if !r.isRunning() {
+		return fmt.Errorf("reloader is not running")
+	}
+
+	select {
+	case r.reloadChan <- event:
+		return nil
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("timeout triggering reload")
+	}
+}
+
 func (r *ConfigReloader) watchFiles() {
 	r.log("debug", "File watcher started")
 
@@ -310,12 +444,15 @@ func (r *ConfigReloader) watchFiles() {
 				newConfig, err := r.loadConfigFromFile(event.Name)
 				if err != nil {
 					r.log("error", fmt.Sprintf("Failed to load config from file %s: %v", event.Name, err))
+					r.mu.Lock()
+					r.recordAudit(SourceFile, err, ActionRejected)
+					r.mu.Unlock()
 					continue
 				}
 
-				// Trigger reload
+				// Trigger reload, stamped on receipt like API and env events
 				select {
-				case r.reloadChan <- newConfig:
+				case r.reloadChan <- &ReloadEvent{Source: SourceFile, Config: newConfig, Timestamp: time.Now()}:
 				case <-time.After(5 * time.Second):
 					r.log("warn", "Timeout sending file-triggered reload")
 				}
@@ -346,13 +483,26 @@ func (r *ConfigReloader) handleSignals() {
 				newConfig, err := r.loadConfigFromFile(r.config.ConfigFile)
 				if err != nil {
 					r.log("error", fmt.Sprintf("Failed to load config for signal reload: %v", err))
+					r.mu.Lock()
+					r.recordAudit(SourceFile, err, ActionRejected)
+					r.mu.Unlock()
 					continue
 				}
 
-				select {
-				case r.reloadChan <- newConfig:
-				case <-time.After(5 * time.Second):
-					r.log("warn", "Timeout sending signal-triggered reload")
+				// Environment variables are re-read on signal and take priority
+				// over the file when both carry the same timestamp
+				timestamp := time.Now()
+				events := []*ReloadEvent{{Source: SourceFile, Config: newConfig, Timestamp: timestamp}}
+				if envConfig, found := loadConfigFromEnv(newConfig); found {
+					events = append(events, &ReloadEvent{Source: SourceEnv, Config: envConfig, Timestamp: timestamp})
+				}
+
+				for _, event := range events {
+					select {
+					case r.reloadChan <- event:
+					case <-time.After(5 * time.Second):
+						r.log("warn", "Timeout sending signal-triggered reload")
+					}
 				}
 			} else {
 				r.log("warn", "Signal received but no config file specified")
@@ -364,42 +514,131 @@ func (r *ConfigReloader) handleSignals() {
 func (r *ConfigReloader) processReloads() {
 	r.log("debug", "Reload processor started")
 
+	var pending []*ReloadEvent
+	var timer *time.Timer
+	var timerC <-chan time.Time
+
 	for {
 		select {
 		case <-r.ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
 			r.log("debug", "Reload processor stopped")
 			return
-		case newConfig := <-r.reloadChan:
-			if err := r.handleReload(newConfig); err != nil {
-				r.log("error", fmt.Sprintf("Failed to handle configuration reload: %v", err))
+		case event, ok := <-r.reloadChan:
+			if !ok || event == nil {
+				continue
 			}
+
+			if r.config.DebounceWindow <= 0 {
+				r.processEvents([]*ReloadEvent{event})
+				continue
+			}
+
+			pending = append(pending, event)
+			r.log("debug", fmt.Sprintf("Config update queued: %s at %s, will process in %s",
+				event.Source, event.Timestamp.Format(time.RFC3339Nano), r.config.DebounceWindow))
+
+			// Restart the debounce window on every new event
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.NewTimer(r.config.DebounceWindow)
+			timerC = timer.C
+		case <-timerC:
+			events := pending
+			pending = nil
+			timer = nil
+			timerC = nil
+			r.processEvents(events)
 		}
 	}
 }
 
-func (r *ConfigReloader) handleReload(newConfig *config.Config) error {
+func (r *ConfigReloader) processEvents(events []*ReloadEvent) {
+	if len(events) > 1 {
+		r.log("info", fmt.Sprintf("Processing %d queued config updates", len(events)))
+	}
+
+	winner, decision := r.resolveEvents(events)
+	if winner == nil {
+		decision.Error = "no valid configuration among queued updates"
+		r.recordDecision(decision)
+		r.log("error", "Failed to handle configuration reload: no valid configuration among queued updates")
+		return
+	}
+
+	if decision.Reason == ReasonPriorityTieBreak {
+		r.log("warn", fmt.Sprintf("Simultaneous updates detected, using priority-based winner: %s", decision.Winner))
+	}
+
+	// resolveEvents has already validated the winner
+	if err := r.applyReload(winner.Source, winner.Config); err != nil {
+		decision.Error = err.Error()
+		r.log("error", fmt.Sprintf("Failed to handle configuration reload: %v", err))
+	} else {
+		decision.Applied = true
+	}
+	r.recordDecision(decision)
+}
+
+func (r *ConfigReloader) recordDecision(decision *ReloadDecision) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	retention := r.config.DecisionLogRetention
+	if retention <= 0 {
+		return
+	}
+
+	r.decisions = append(r.decisions, decision)
+	if len(r.decisions) > retention {
+		r.decisions = r.decisions[len(r.decisions)-retention:]
+	}
+}
+
+// applyReload probes and applies a configuration that has already passed validation.
+func (r *ConfigReloader) applyReload(source ReloadSource, newConfig *config.Config) error {
 	r.log("info", "Processing configuration reload")
 
-	// Validate the new configuration
-	if r.config.ValidateBeforeReload {
-		if err := r.validateConfig(newConfig); err != nil {
-			return fmt.Errorf("configuration validation failed: %w", err)
+	// Health probes run without the lock because they may dial the OTLP endpoint
+
+	// A configuration that fails its health check is rejected before it is
+	// applied, except for connection failures, which the exporter recovers from
+	var probeErr error
+	if r.config.HealthCheck {
+		probeErr = r.probeConfig(newConfig)
+		if probeErr != nil && ClassifyError(probeErr).ShouldRollback() {
+			r.mu.Lock()
+			r.recordAudit(source, probeErr, ActionRejected)
+			r.mu.Unlock()
+			return fmt.Errorf("health check failed: %w", probeErr)
 		}
 	}
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	// Backup current configuration if enabled
+	backedUp := false
 	if r.config.BackupOnReload {
 		r.backupCurrentConfig()
+		backedUp = len(r.backupConfigs) > 0
 	}
 
 	oldConfig := r.currentConfig
+	oldAppliedAt := r.appliedAt
 
 	// Apply the new configuration
 	if err := r.applyConfig(newConfig); err != nil {
-		return fmt.Errorf("failed to apply new configuration: %w", err)
+		err = &ReloadError{Category: FailureInitialization, Err: err}
+		return r.recoverFromFailure(source, err, oldConfig, oldAppliedAt, backedUp,
+			"failed to apply new configuration")
+	}
+
+	if probeErr != nil {
+		r.recordAudit(source, probeErr, ActionContinue)
 	}
 
 	// Call the callback if provided
@@ -413,16 +652,43 @@ func (r *ConfigReloader) handleReload(newConfig *config.Config) error {
 	return nil
 }
 
+// recoverFromFailure reverts to the previous configuration after a failed
+// apply when auto rollback is enabled, and audits the outcome.
+// The caller must hold the write lock.
+func (r *ConfigReloader) recoverFromFailure(source ReloadSource, err error, oldConfig *config.Config,
+	oldAppliedAt time.Time, backedUp bool, message string) error {
+	if !r.config.AutoRollback || oldConfig == nil {
+		r.recordAudit(source, err, ActionContinue)
+		return fmt.Errorf("%s: %w", message, err)
+	}
+
+	var rollbackErr error
+	if backedUp {
+		rollbackErr = r.rollbackTo(len(r.backupConfigs) - 1)
+	} else {
+		rollbackErr = r.applyConfig(oldConfig)
+	}
+	if rollbackErr != nil {
+		r.recordAudit(source, err, ActionContinue)
+		return fmt.Errorf("%s: %w (automatic rollback failed: %v)", message, err, rollbackErr)
+	}
+	r.appliedAt = oldAppliedAt
+
+	r.recordAudit(source, err, ActionRollback)
+	r.log("warn", "Automatically rolled back to previous configuration")
+	return fmt.Errorf("%s, rolled back: %w", message, err)
+}
+
 func (r *ConfigReloader) validateConfig(cfg *config.Config) error {
 	// Basic validation
 	if err := cfg.Validate(); err != nil {
-		return err
+		return &ReloadError{Category: FailureValidation, Err: err}
 	}
 
 	// Custom validation if provided
 	if r.config.ValidationFunc != nil {
 		if err := r.config.ValidationFunc(cfg); err != nil {
-			return err
+			return &ReloadError{Category: FailureValidation, Err: err}
 		}
 	}
 
@@ -439,25 +705,41 @@ func (r *ConfigReloader) applyConfig(newConfig *config.Config) error {
 
 	// Update current configuration
 	r.currentConfig = newConfig
+	r.appliedAt = time.Now()
+
+	r.applyNamedLevels(newOption)
 
 	return nil
 }
 
+// rollbackTo applies the backup at index and discards it along with newer backups.
+// The caller must hold the write lock.
+func (r *ConfigReloader) rollbackTo(index int) error {
+	if err := r.applyConfig(r.backupConfigs[index]); err != nil {
+		return err
+	}
+
+	r.backupConfigs = r.backupConfigs[:index]
+	r.backupAppliedAt = r.backupAppliedAt[:index]
+	return nil
+}
+
 func (r *ConfigReloader) backupCurrentConfig() {
 	// Add current config to backup list
-	configCopy := *r.currentConfig
-	r.backupConfigs = append(r.backupConfigs, &configCopy)
+	r.backupConfigs = append(r.backupConfigs, r.currentConfig.Clone())
+	r.backupAppliedAt = append(r.backupAppliedAt, r.appliedAt)
 
 	// Maintain backup retention limit
 	if len(r.backupConfigs) > r.config.BackupRetention {
 		r.backupConfigs = r.backupConfigs[len(r.backupConfigs)-r.config.BackupRetention:]
+		r.backupAppliedAt = r.backupAppliedAt[len(r.backupAppliedAt)-r.config.BackupRetention:]
 	}
 }
 
 func (r *ConfigReloader) loadConfigFromFile(filename string) (*config.Config, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, &ReloadError{Category: FailureInitialization, Err: fmt.Errorf("failed to read config file: %w", err)}
 	}
 
 	cfg := &config.Config{}
@@ -467,14 +749,14 @@ func (r *ConfigReloader) loadConfigFromFile(filename string) (*config.Config, er
 	switch ext {
 	case ".yaml", ".yml":
 		if err := yaml.Unmarshal(data, cfg); err != nil {
-			return nil, fmt.Errorf("failed to parse YAML config: %w", err)
+			return nil, &ReloadError{Category: FailureParse, Err: fmt.Errorf("failed to parse YAML config: %w", err)}
 		}
 	case ".json":
 		if err := json.Unmarshal(data, cfg); err != nil {
-			return nil, fmt.Errorf("failed to parse JSON config: %w", err)
+			return nil, &ReloadError{Category: FailureParse, Err: fmt.Errorf("failed to parse JSON config: %w", err)}
 		}
 	default:
-		return nil, fmt.Errorf("unsupported config file format: %s", ext)
+		return nil, &ReloadError{Category: FailureParse, Err: fmt.Errorf("unsupported config file format: %s", ext)}
 	}
 
 	return cfg, nil
@@ -495,15 +777,51 @@ func (r *ConfigReloader) configToLogOption(cfg *config.Config) *option.LogOption
 		Development:       cfg.Development,
 		DisableCaller:     cfg.DisableCaller,
 		DisableStacktrace: cfg.DisableStacktrace,
+		Levels:            cfg.Levels,
+		DuplicateKeys:     cfg.DuplicateKeys,
+	}
+
+	if cfg.FieldNaming != nil {
+		opt.FieldNaming = &option.FieldNamingOption{
+			Scheme:  cfg.FieldNaming.Scheme,
+			Mapping: cfg.FieldNaming.Mapping,
+		}
+	}
+
+	if cfg.Encoder != nil {
+		opt.Encoder = &option.EncoderOption{
+			TimeLayout:    cfg.Encoder.TimeLayout,
+			TimeZone:      cfg.Encoder.TimeZone,
+			LevelCase:     cfg.Encoder.LevelCase,
+			Caller:        cfg.Encoder.Caller,
+			Duration:      cfg.Encoder.Duration,
+			TimeKey:       cfg.Encoder.TimeKey,
+			LevelKey:      cfg.Encoder.LevelKey,
+			MessageKey:    cfg.Encoder.MessageKey,
+			CallerKey:     cfg.Encoder.CallerKey,
+			NameKey:       cfg.Encoder.NameKey,
+			StacktraceKey: cfg.Encoder.StacktraceKey,
+		}
+	}
+
+	if cfg.Limits != nil {
+		opt.Limits = &option.LimitsOption{
+			MaxMessageLength: cfg.Limits.MaxMessageLength,
+			MaxValueLength:   cfg.Limits.MaxValueLength,
+			MaxFields:        cfg.Limits.MaxFields,
+			MaxDepth:         cfg.Limits.MaxDepth,
+			MaxRecordBytes:   cfg.Limits.MaxRecordBytes,
+		}
 	}
 
 	if cfg.OTLP != nil {
 		opt.OTLP = &option.OTLPOption{
-			Enabled:  cfg.OTLP.Enabled,
-			Endpoint: cfg.OTLP.Endpoint,
-			Protocol: cfg.OTLP.Protocol,
-			Timeout:  cfg.OTLP.Timeout,
-			Headers:  cfg.OTLP.Headers,
+			Enabled:       cfg.OTLP.Enabled,
+			Endpoint:      cfg.OTLP.Endpoint,
+			Protocol:      cfg.OTLP.Protocol,
+			Timeout:       cfg.OTLP.Timeout,
+			Headers:       cfg.OTLP.Headers,
+			FlattenGroups: cfg.OTLP.FlattenGroups,
 		}
 	}
 
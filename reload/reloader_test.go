@@ -159,7 +159,11 @@ func TestConfigReloader_TriggerReload(t *testing.T) {
 	}
 	factory := factory.NewLoggerFactory(opt)
 
-	reloader, err := NewConfigReloader(nil, cfg, factory)
+	// Apply API events immediately instead of after the default debounce window
+	reloadConfig := DefaultReloadConfig()
+	reloadConfig.DebounceWindow = 0
+
+	reloader, err := NewConfigReloader(reloadConfig, cfg, factory)
 	if err != nil {
 		t.Fatalf("Failed to create reloader: %v", err)
 	}
@@ -731,6 +735,10 @@ func TestDefaultReloadConfig(t *testing.T) {
 		t.Error("Default config should keep 5 backup configurations")
 	}
 
+	if defaultCfg.DebounceWindow != 3*time.Second {
+		t.Error("Default config should have 3 second debounce window")
+	}
+
 	expectedSignals := []os.Signal{syscall.SIGUSR1, syscall.SIGHUP}
 	if len(defaultCfg.Signals) != len(expectedSignals) {
 		t.Errorf("Expected %d default signals, got %d", len(expectedSignals), len(defaultCfg.Signals))